@@ -61,6 +61,10 @@ func (c *Client) GetKey(keyID string) (*Key, error) {
 		return nil, err
 	}
 
+	// /key/info returns the key's attributes in an "info" envelope
+	if info, ok := resp["info"].(map[string]interface{}); ok {
+		return c.parseKeyResponse(info)
+	}
 	return c.parseKeyResponse(resp)
 }
 
@@ -77,7 +81,6 @@ func (c *Client) UpdateKey(key *Key) (*Key, error) {
 		"model_max_budget": key.ModelMaxBudget,
 		"model_rpm_limit":  key.ModelRPMLimit,
 		"model_tpm_limit":  key.ModelTPMLimit,
-		"blocked":          key.Blocked,
 	}
 
 	// Only add pointer fields if they are explicitly set
@@ -107,6 +110,9 @@ func (c *Client) UpdateKey(key *Key) (*Key, error) {
 	if len(key.Tags) > 0 {
 		updateData["tags"] = key.Tags
 	}
+	if key.ObjectPermission != nil {
+		updateData["object_permission"] = key.ObjectPermission
+	}
 
 	resp, err := c.sendRequest("POST", "/key/update", updateData)
 	if err != nil {
@@ -116,6 +122,47 @@ func (c *Client) UpdateKey(key *Key) (*Key, error) {
 	return c.parseKeyResponse(resp)
 }
 
+// RegenerateKey replaces the value of an existing key with newKey, keeping
+// its budgets, limits and spend. The key's token_id changes with its value.
+func (c *Client) RegenerateKey(keyID, newKey string) (*Key, error) {
+	resp, err := c.sendRequest("POST", "/key/regenerate", map[string]interface{}{
+		"key":     keyID,
+		"new_key": newKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.parseKeyResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := resp["token"].(string); ok && key.TokenID == "" {
+		key.TokenID = token
+	}
+	if key.TokenID == "" && key.Key != "" {
+		key.TokenID = hashKey(key.Key)
+	}
+	return key, nil
+}
+
+// BlockKey suspends a key through /key/block, which also invalidates the
+// proxy's cached copy of the key.
+func (c *Client) BlockKey(keyID string) error {
+	_, err := c.sendRequest("POST", "/key/block", map[string]interface{}{
+		"key": keyID,
+	})
+	return err
+}
+
+// UnblockKey re-enables a key suspended by BlockKey.
+func (c *Client) UnblockKey(keyID string) error {
+	_, err := c.sendRequest("POST", "/key/unblock", map[string]interface{}{
+		"key": keyID,
+	})
+	return err
+}
+
 func (c *Client) DeleteKey(keyID string) error {
 	payload := map[string]interface{}{
 		"keys": []string{keyID},
@@ -251,7 +298,43 @@ func (c *Client) parseKeyResponse(resp map[string]interface{}) (*Key, error) {
 					}
 				}
 			}
+		case "object_permission":
+			createdKey.ObjectPermission = decodeObjectPermission(v)
+		case "organization_id":
+			if s, ok := v.(string); ok {
+				createdKey.OrganizationID = s
+			}
+		case "key_name":
+			if s, ok := v.(string); ok {
+				createdKey.KeyName = s
+			}
+		case "expires":
+			if s, ok := v.(string); ok {
+				createdKey.Expires = s
+			}
+		case "created_at":
+			if s, ok := v.(string); ok {
+				createdKey.CreatedAt = s
+			}
+		}
+	}
+
+	// The proxy persists guardrails, tags and per-model limits in the key
+	// metadata, so fall back to it when they are not returned as top-level fields
+	if createdKey.Metadata != nil {
+		if createdKey.Guardrails == nil {
+			createdKey.Guardrails = metadataStringList(createdKey.Metadata, "guardrails")
 		}
+		if createdKey.Tags == nil {
+			createdKey.Tags = metadataStringList(createdKey.Metadata, "tags")
+		}
+		if m, ok := createdKey.Metadata["model_rpm_limit"].(map[string]interface{}); ok && createdKey.ModelRPMLimit == nil {
+			createdKey.ModelRPMLimit = m
+		}
+		if m, ok := createdKey.Metadata["model_tpm_limit"].(map[string]interface{}); ok && createdKey.ModelTPMLimit == nil {
+			createdKey.ModelTPMLimit = m
+		}
+		createdKey.Metadata = stripReservedMetadata(createdKey.Metadata, keyReservedMetadataKeys...)
 	}
 
 	return createdKey, nil
@@ -313,6 +396,10 @@ func (c *Client) sendRequest(method, path string, body interface{}) (map[string]
 	return result, nil
 }
 
+// keyReservedMetadataKeys are metadata keys the proxy uses to store key
+// attributes that the provider manages as first-class arguments.
+var keyReservedMetadataKeys = []string{"guardrails", "tags", "model_rpm_limit", "model_tpm_limit"}
+
 var sensitiveLogFields = map[string]bool{
 	"api_key":               true,
 	"key":                   true,
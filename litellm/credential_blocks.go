@@ -0,0 +1,201 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// credentialBlockField is an attribute of a typed litellm_credential block.
+type credentialBlockField struct {
+	name        string
+	param       string // credential_values key the field is sent as
+	required    bool
+	sensitive   bool
+	validate    schema.SchemaValidateFunc
+	description string
+}
+
+// credentialBlock describes one of the typed provider blocks of
+// litellm_credential. Its fields are merged into credential_values.
+type credentialBlock struct {
+	name   string
+	fields []credentialBlockField
+}
+
+var credentialBlocks = []credentialBlock{
+	{
+		name: "openai",
+		fields: []credentialBlockField{
+			{name: "api_key", param: "api_key", sensitive: true, description: "OpenAI API key"},
+			{name: "api_base", param: "api_base", validate: validation.IsURLWithHTTPorHTTPS, description: "Base URL of the OpenAI API"},
+			{name: "organization", param: "organization", description: "OpenAI organization ID"},
+		},
+	},
+	{
+		name: "azure",
+		fields: []credentialBlockField{
+			{name: "api_base", param: "api_base", required: true, validate: validation.IsURLWithHTTPS, description: "Azure endpoint, e.g. https://my-resource.openai.azure.com"},
+			{name: "api_version", param: "api_version", description: "Azure OpenAI API version"},
+			{name: "api_key", param: "api_key", sensitive: true, description: "Azure API key"},
+			{name: "tenant_id", param: "tenant_id", validate: validation.IsUUID, description: "Entra ID tenant for service principal authentication"},
+			{name: "client_id", param: "client_id", validate: validation.IsUUID, description: "Entra ID application (client) ID"},
+			{name: "client_secret", param: "client_secret", sensitive: true, description: "Entra ID client secret"},
+		},
+	},
+	{
+		name: "aws",
+		fields: []credentialBlockField{
+			{name: "region_name", param: "aws_region_name", required: true, description: "AWS region"},
+			{name: "role_name", param: "aws_role_name", description: "IAM role to assume"},
+			{name: "session_name", param: "aws_session_name", description: "Session name used when assuming role_name"},
+			{name: "access_key_id", param: "aws_access_key_id", sensitive: true, description: "AWS access key ID"},
+			{name: "secret_access_key", param: "aws_secret_access_key", sensitive: true, description: "AWS secret access key"},
+		},
+	},
+	{
+		name: "vertex",
+		fields: []credentialBlockField{
+			{name: "project", param: "vertex_project", required: true, description: "Google Cloud project ID"},
+			{name: "location", param: "vertex_location", required: true, description: "Vertex AI location, e.g. us-central1"},
+			{name: "credentials", param: "vertex_credentials", sensitive: true, validate: validation.StringIsJSON, description: "Service account key JSON"},
+		},
+	},
+	{
+		name: "anthropic",
+		fields: []credentialBlockField{
+			{name: "api_key", param: "api_key", sensitive: true, description: "Anthropic API key"},
+			{name: "api_base", param: "api_base", validate: validation.IsURLWithHTTPorHTTPS, description: "Base URL of the Anthropic API"},
+		},
+	},
+}
+
+// credentialValueSources lists the attributes that can provide
+// credential_values; at least one of them must be set.
+func credentialValueSources() []string {
+	sources := []string{"credential_values", "credential_values_wo"}
+	for _, block := range credentialBlocks {
+		sources = append(sources, block.name)
+	}
+	return sources
+}
+
+// credentialBlockSchemas returns the schemas of the typed provider blocks.
+// A credential holds the settings of a single provider, so the blocks
+// conflict with each other. Sensitive fields get a write-only <name>_wo
+// variant and a <name>_wo_version that resends it.
+func credentialBlockSchemas() map[string]*schema.Schema {
+	schemas := make(map[string]*schema.Schema, len(credentialBlocks))
+	for _, block := range credentialBlocks {
+		fields := make(map[string]*schema.Schema, len(block.fields))
+		for _, field := range block.fields {
+			fields[field.name] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     field.required,
+				Optional:     !field.required,
+				Sensitive:    field.sensitive,
+				ValidateFunc: field.validate,
+				Description:  field.description,
+			}
+			if field.sensitive {
+				fields[field.name+"_wo"] = &schema.Schema{
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					WriteOnly:     true,
+					ValidateFunc:  field.validate,
+					ConflictsWith: []string{fmt.Sprintf("%s.0.%s", block.name, field.name)},
+					Description:   fmt.Sprintf("Write-only %s: sent to the proxy but never stored in state", field.name),
+				}
+				fields[field.name+"_wo_version"] = &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: fmt.Sprintf("Change this value to resend %s_wo to the proxy", field.name),
+				}
+			}
+		}
+
+		var conflicts []string
+		for _, other := range credentialBlocks {
+			if other.name != block.name {
+				conflicts = append(conflicts, other.name)
+			}
+		}
+		schemas[block.name] = &schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: conflicts,
+			AtLeastOneOf:  credentialValueSources(),
+			Description:   fmt.Sprintf("Typed %s credential values, merged into credential_values", block.name),
+			Elem:          &schema.Resource{Schema: fields},
+		}
+	}
+	return schemas
+}
+
+// expandCredentialBlocks adds the fields of the configured typed block to
+// credentialValues.
+func expandCredentialBlocks(d credentialGetter, credentialValues map[string]interface{}) {
+	for _, block := range credentialBlocks {
+		items, ok := d.Get(block.name).([]interface{})
+		if !ok || len(items) == 0 || items[0] == nil {
+			continue
+		}
+		values := items[0].(map[string]interface{})
+		for _, field := range block.fields {
+			if v, _ := values[field.name].(string); v != "" {
+				credentialValues[field.param] = v
+			}
+		}
+	}
+}
+
+// addCredentialBlockWriteOnlySecrets adds the write-only secrets of the
+// configured typed block to credentialValues. Changing <name>_wo_version
+// changes the block, which makes expandCredentialValues resend them.
+func addCredentialBlockWriteOnlySecrets(d *schema.ResourceData, credentialValues map[string]interface{}) {
+	for _, block := range credentialBlocks {
+		for _, field := range block.fields {
+			if !field.sensitive {
+				continue
+			}
+			if v := getWriteOnlyString(d, cty.GetAttrPath(block.name).IndexInt(0).GetAttr(field.name+"_wo")); v != "" {
+				credentialValues[field.param] = v
+			}
+		}
+	}
+}
+
+// credentialGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type credentialGetter interface {
+	Get(key string) interface{}
+}
+
+// resourceLiteLLMCredentialCustomizeDiff rejects credential_values keys that
+// the typed block also sets, since one would silently override the other.
+func resourceLiteLLMCredentialCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("credential_values") {
+		return nil
+	}
+
+	fromBlocks := make(map[string]interface{})
+	expandCredentialBlocks(d, fromBlocks)
+
+	var duplicates []string
+	for key := range d.Get("credential_values").(map[string]interface{}) {
+		if _, ok := fromBlocks[key]; ok {
+			duplicates = append(duplicates, key)
+		}
+	}
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		return fmt.Errorf("credential_values sets %v, which the typed block already sets", duplicates)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package litellm
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,7 +40,7 @@ func dataSourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) erro
 	modelID := d.Get("model_id").(string)
 
 	// Use the same endpoint as the resource read operation
-	endpoint := fmt.Sprintf("/credentials/by_name/%s", credentialName)
+	endpoint := fmt.Sprintf("/credentials/by_name/%s", url.PathEscape(credentialName))
 	if modelID != "" {
 		endpoint += fmt.Sprintf("?model_id=%s", modelID)
 	}
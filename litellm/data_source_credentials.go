@@ -0,0 +1,84 @@
+package litellm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLiteLLMCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLiteLLMCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"credentials": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Credentials stored on the proxy, sorted by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the credential",
+						},
+						"credential_info": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional information about the credential",
+						},
+						// Note: credential_values are not exposed in data sources for security reasons
+					},
+				},
+			},
+			"credential_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted names of the credentials",
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMCredentialsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	credentials, err := ListCredentials(client)
+	if err != nil {
+		return err
+	}
+	sort.Slice(credentials, func(i, j int) bool {
+		return credentials[i].CredentialName < credentials[j].CredentialName
+	})
+
+	items := make([]interface{}, 0, len(credentials))
+	names := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		info := make(map[string]interface{}, len(credential.CredentialInfo))
+		for k, v := range credential.CredentialInfo {
+			if str, ok := v.(string); ok {
+				info[k] = str
+			} else {
+				info[k] = fmt.Sprint(v)
+			}
+		}
+		items = append(items, map[string]interface{}{
+			"credential_name": credential.CredentialName,
+			"credential_info": info,
+		})
+		names = append(names, credential.CredentialName)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(names, ",")))))
+	if err := d.Set("credentials", items); err != nil {
+		return fmt.Errorf("error setting credentials: %w", err)
+	}
+	d.Set("credential_names", names)
+
+	return nil
+}
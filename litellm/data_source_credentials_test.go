@@ -0,0 +1,43 @@
+package litellm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCredentialsDataSourceListsNamesAndInfo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/credentials" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"credentials": [
+			{"credential_name": "openai-prod", "credential_info": {"custom_llm_provider": "openai"}, "credential_values": {"api_key": "sk-secret"}},
+			{"credential_name": "azure-prod", "credential_info": {"custom_llm_provider": "azure", "rotated": true}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMCredentials().Schema, map[string]interface{}{})
+	if err := dataSourceLiteLLMCredentialsRead(d, NewClient(srv.URL, "test-key", true)); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	names := d.Get("credential_names").([]interface{})
+	if len(names) != 2 || names[0] != "azure-prod" || names[1] != "openai-prod" {
+		t.Fatalf("expected sorted credential names, got %v", names)
+	}
+	if d.Get("credentials.0.credential_info.rotated") != "true" || d.Get("credentials.1.credential_info.custom_llm_provider") != "openai" {
+		t.Fatalf("credential_info not flattened: %v", d.Get("credentials"))
+	}
+	for _, v := range d.State().Attributes {
+		if strings.Contains(v, "sk-secret") {
+			t.Fatal("credential value exposed by the data source")
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package litellm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keyListFilters are the litellm_keys arguments passed to /key/list.
+var keyListFilters = []string{"team_id", "user_id", "organization_id", "key_alias"}
+
+func dataSourceLiteLLMKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLiteLLMKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return keys belonging to this team",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return keys belonging to this user",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return keys belonging to this organization",
+			},
+			"key_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return keys with this alias",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Keys matching the filters. Raw key values are never returned",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SHA-256 hash of the key, used as its ID",
+						},
+						"key_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Abbreviated key shown by the proxy, e.g. sk-...AbCd",
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"models": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_budget": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"soft_budget": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"budget_duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tpm_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rpm_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"spend": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"expires": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiry timestamp of the key, empty if it does not expire",
+						},
+						"blocked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMKeysRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	filters := url.Values{}
+	for _, attr := range keyListFilters {
+		if v, ok := d.GetOk(attr); ok {
+			filters.Set(attr, v.(string))
+		}
+	}
+
+	keys, err := ListKeys(client, filters)
+	if err != nil {
+		return err
+	}
+
+	var result []interface{}
+	var ids []string
+	for i := range keys {
+		key := flattenListedKey(&keys[i])
+		// Older proxies ignore some /key/list filters, so apply them here too
+		if !keyMatchesFilters(key, filters) {
+			continue
+		}
+		result = append(result, key)
+		ids = append(ids, keys[i].TokenID)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(filters.Encode()+"|"+strings.Join(ids, ",")))))
+	if err := d.Set("keys", result); err != nil {
+		return fmt.Errorf("error setting keys: %w", err)
+	}
+	return nil
+}
+
+func keyMatchesFilters(key map[string]interface{}, filters url.Values) bool {
+	for attr := range filters {
+		if key[attr].(string) != filters.Get(attr) {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenListedKey converts a key from /key/list into the litellm_keys shape.
+// The raw key value is deliberately left out.
+func flattenListedKey(key *Key) map[string]interface{} {
+	result := map[string]interface{}{
+		"token_id":        key.TokenID,
+		"key_alias":       key.KeyAlias,
+		"key_name":        key.KeyName,
+		"user_id":         key.UserID,
+		"team_id":         key.TeamID,
+		"organization_id": key.OrganizationID,
+		"models":          key.Models,
+		"budget_duration": key.BudgetDuration,
+		"spend":           key.Spend,
+		"expires":         key.Expires,
+		"blocked":         key.Blocked,
+		"tags":            key.Tags,
+	}
+	if key.MaxBudget != nil {
+		result["max_budget"] = *key.MaxBudget
+	}
+	if key.SoftBudget != nil {
+		result["soft_budget"] = *key.SoftBudget
+	}
+	if key.TPMLimit != nil {
+		result["tpm_limit"] = *key.TPMLimit
+	}
+	if key.RPMLimit != nil {
+		result["rpm_limit"] = *key.RPMLimit
+	}
+	return result
+}
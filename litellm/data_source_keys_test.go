@@ -0,0 +1,68 @@
+package litellm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestKeysDataSourceWalksPagesAndFilters(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/key/list" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"total_pages": 2, "keys": [
+				{"token": "hash-1", "key": "sk-raw-secret", "key_name": "sk-...AbCd", "key_alias": "ci", "team_id": "team-1",
+				 "max_budget": 10, "spend": 1.5, "expires": "2027-01-01T00:00:00Z", "models": ["gpt-4o"], "tpm_limit": 100}
+			]}`))
+		default:
+			w.Write([]byte(`{"total_pages": 2, "keys": [
+				{"token": "hash-2", "key_alias": "batch", "team_id": "team-1", "spend": 0, "expires": null},
+				{"token": "hash-3", "key_alias": "other", "team_id": "team-2"}
+			]}`))
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMKeys().Schema, map[string]interface{}{
+		"team_id": "team-1",
+	})
+	if err := dataSourceLiteLLMKeysRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if len(queries) != 2 || !strings.Contains(queries[0], "team_id=team-1") || !strings.Contains(queries[1], "page=2") {
+		t.Fatalf("expected two filtered page requests, got %v", queries)
+	}
+
+	keys := d.Get("keys").([]interface{})
+	if len(keys) != 2 {
+		t.Fatalf("expected the 2 keys of team-1, got %v", keys)
+	}
+	first := keys[0].(map[string]interface{})
+	if first["token_id"] != "hash-1" || first["key_name"] != "sk-...AbCd" || first["max_budget"] != 10.0 ||
+		first["spend"] != 1.5 || first["expires"] != "2027-01-01T00:00:00Z" || first["tpm_limit"] != 100 {
+		t.Fatalf("unexpected first key: %v", first)
+	}
+	if d.Get("keys.1.token_id").(string) != "hash-2" || d.Get("keys.1.expires").(string) != "" {
+		t.Fatalf("unexpected second key: %v", keys[1])
+	}
+
+	for _, k := range keys {
+		for attr, v := range k.(map[string]interface{}) {
+			if s, ok := v.(string); ok && strings.Contains(s, "sk-raw-secret") {
+				t.Fatalf("raw key exposed in %s", attr)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package litellm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLiteLLMModel() *schema.Resource {
+	s := modelDeploymentSchema()
+	delete(s, "id")
+	s["model_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"model_id", "model_name"},
+		Description:  "Model ID of the deployment to look up",
+	}
+	s["model_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"model_id", "model_name"},
+		Description:  "Model name of the deployment to look up. Fails if several deployments share the name",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLiteLLMModelRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLiteLLMModelRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	var model *ModelResponse
+	if modelID, ok := d.GetOk("model_id"); ok {
+		var err error
+		model, err = getModel(client, modelID.(string))
+		if err != nil {
+			return err
+		}
+		if model == nil {
+			return fmt.Errorf("model '%s' not found", modelID)
+		}
+	} else {
+		modelName := d.Get("model_name").(string)
+		models, err := ListModels(client)
+		if err != nil {
+			return err
+		}
+
+		var matches []ModelResponse
+		var ids []string
+		for _, candidate := range models {
+			if candidate.ModelName == modelName {
+				matches = append(matches, candidate)
+				ids = append(ids, candidate.ModelInfo.ID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return fmt.Errorf("no model found with model_name '%s'", modelName)
+		case 1:
+			model = &matches[0]
+		default:
+			return fmt.Errorf("model_name '%s' matches %d deployments (%s), look the model up by model_id instead",
+				modelName, len(matches), strings.Join(ids, ", "))
+		}
+	}
+
+	deployment := flattenModelDeployment(*model)
+	d.SetId(model.ModelInfo.ID)
+	d.Set("model_id", model.ModelInfo.ID)
+	for k, v := range deployment {
+		if k == "id" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package litellm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestModelDataSourceLooksUpByID(t *testing.T) {
+	srv := modelListServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_id": "m-1",
+	})
+	if err := dataSourceLiteLLMModelRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if d.Id() != "m-1" || d.Get("model_name").(string) != "gpt-4o" || d.Get("custom_llm_provider").(string) != "openai" {
+		t.Fatalf("unexpected model: id=%q name=%q provider=%q", d.Id(), d.Get("model_name"), d.Get("custom_llm_provider"))
+	}
+	if !d.Get("db_model").(bool) || d.Get("mode").(string) != "chat" {
+		t.Fatalf("model_info not exposed: db_model=%v mode=%q", d.Get("db_model"), d.Get("mode"))
+	}
+	if _, ok := d.Get("litellm_params").(map[string]interface{})["api_key"]; ok {
+		t.Fatalf("api_key exposed by data source")
+	}
+}
+
+func TestModelDataSourceLooksUpByName(t *testing.T) {
+	srv := modelListServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_name": "embed",
+	})
+	if err := dataSourceLiteLLMModelRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if d.Id() != "m-3" || d.Get("model_id").(string) != "m-3" || d.Get("base_model").(string) != "text-embedding-3-small" {
+		t.Fatalf("unexpected model: id=%q base_model=%q", d.Id(), d.Get("base_model"))
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_name": "gpt-4o",
+	})
+	err := dataSourceLiteLLMModelRead(d, client)
+	if err == nil || !strings.Contains(err.Error(), "m-1, m-2") {
+		t.Fatalf("expected an ambiguity error listing both deployments, got %v", err)
+	}
+}
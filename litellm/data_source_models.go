@@ -0,0 +1,225 @@
+package litellm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// modelDeploymentSchema describes one deployment returned by the litellm_models
+// data source.
+func modelDeploymentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Model ID of the deployment",
+		},
+		"model_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public model name clients request",
+		},
+		"custom_llm_provider": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Provider the deployment routes to",
+		},
+		"base_model": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Base model used for routing or, when set in model_info, for cost lookup",
+		},
+		"mode": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Model mode, e.g. chat or embedding",
+		},
+		"tier": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Model tier",
+		},
+		"team_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Team the deployment belongs to, if any",
+		},
+		"db_model": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the deployment is stored in the database rather than config.yaml",
+		},
+		"litellm_params": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Non-secret litellm_params of the deployment",
+		},
+	}
+}
+
+func dataSourceLiteLLMModels() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLiteLLMModelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"model_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return deployments with this model name",
+			},
+			"custom_llm_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return deployments routing to this provider",
+			},
+			"mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return deployments with this mode",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return deployments belonging to this team",
+			},
+			"db_model": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return deployments stored in the database (true) or defined in config.yaml (false)",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Deployments matching the filters",
+				Elem:        &schema.Resource{Schema: modelDeploymentSchema()},
+			},
+			"model_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted, distinct model names of the matching deployments",
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMModelsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	models, err := ListModels(client)
+	if err != nil {
+		return err
+	}
+
+	var deployments []interface{}
+	var ids []string
+	names := make(map[string]bool)
+	for _, model := range models {
+		deployment := flattenModelDeployment(model)
+		if !modelMatchesFilters(d, deployment) {
+			continue
+		}
+		deployments = append(deployments, deployment)
+		ids = append(ids, model.ModelInfo.ID)
+		names[model.ModelName] = true
+	}
+
+	modelNames := make([]string, 0, len(names))
+	for name := range names {
+		modelNames = append(modelNames, name)
+	}
+	sort.Strings(modelNames)
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ",")))))
+	if err := d.Set("models", deployments); err != nil {
+		return fmt.Errorf("error setting models: %w", err)
+	}
+	d.Set("model_names", modelNames)
+
+	return nil
+}
+
+func modelMatchesFilters(d *schema.ResourceData, deployment map[string]interface{}) bool {
+	for _, attr := range []string{"model_name", "custom_llm_provider", "mode", "team_id"} {
+		if v, ok := d.GetOk(attr); ok && v.(string) != deployment[attr].(string) {
+			return false
+		}
+	}
+	if v, ok := d.GetOkExists("db_model"); ok && v.(bool) != deployment["db_model"].(bool) {
+		return false
+	}
+	return true
+}
+
+// splitModelRoute splits litellm_params.model, "<custom_llm_provider>/<base_model>",
+// into its parts. Both are empty when the model has no provider prefix.
+func splitModelRoute(model string) (string, string) {
+	if parts := strings.SplitN(model, "/", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return "", ""
+}
+
+// flattenModelDeployment converts a model from /model/info into the
+// modelDeploymentSchema shape.
+func flattenModelDeployment(model ModelResponse) map[string]interface{} {
+	routedProvider, routedModel := splitModelRoute(model.LiteLLMParams.Model)
+
+	return map[string]interface{}{
+		"id":                  model.ModelInfo.ID,
+		"model_name":          model.ModelName,
+		"custom_llm_provider": GetStringValue(model.LiteLLMParams.CustomLLMProvider, routedProvider),
+		"base_model":          GetStringValue(model.ModelInfo.BaseModel, routedModel),
+		"mode":                model.ModelInfo.Mode,
+		"tier":                model.ModelInfo.Tier,
+		"team_id":             model.ModelInfo.TeamID,
+		"db_model":            model.ModelInfo.DBModel,
+		"litellm_params":      nonSecretModelParams(model.LiteLLMParams),
+	}
+}
+
+// nonSecretModelParams returns the litellm_params that are safe to expose from
+// a data source. API keys, AWS credentials and Vertex credentials are left out.
+func nonSecretModelParams(params LiteLLMParams) map[string]interface{} {
+	result := make(map[string]interface{})
+	setString := func(key, value string) {
+		if value != "" {
+			result[key] = value
+		}
+	}
+	setFloat := func(key string, value float64) {
+		if value != 0 {
+			result[key] = strconv.FormatFloat(value, 'g', -1, 64)
+		}
+	}
+
+	setString("model", params.Model)
+	setString("custom_llm_provider", params.CustomLLMProvider)
+	setString("api_base", params.APIBase)
+	setString("api_version", params.APIVersion)
+	setString("reasoning_effort", params.ReasoningEffort)
+	setString("aws_region_name", params.AWSRegionName)
+	setString("vertex_project", params.VertexProject)
+	setString("vertex_location", params.VertexLocation)
+	setString("litellm_credential_name", params.LiteLLMCredentialName)
+	if params.TPM != 0 {
+		result["tpm"] = strconv.Itoa(params.TPM)
+	}
+	if params.RPM != 0 {
+		result["rpm"] = strconv.Itoa(params.RPM)
+	}
+	setFloat("input_cost_per_token", params.InputCostPerToken)
+	setFloat("output_cost_per_token", params.OutputCostPerToken)
+	setFloat("input_cost_per_pixel", params.InputCostPerPixel)
+	setFloat("output_cost_per_pixel", params.OutputCostPerPixel)
+	setFloat("input_cost_per_second", params.InputCostPerSecond)
+	setFloat("output_cost_per_second", params.OutputCostPerSecond)
+
+	return result
+}
@@ -0,0 +1,99 @@
+package litellm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func modelListServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/model/info" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		deployments := []string{
+			`{"model_name": "gpt-4o", "litellm_params": {"model": "openai/gpt-4o", "api_key": "sk-secret", "rpm": 100, "input_cost_per_token": 0.0000025},
+			 "model_info": {"id": "m-1", "db_model": true, "mode": "chat", "team_id": "team-1"}}`,
+			`{"model_name": "gpt-4o", "litellm_params": {"model": "azure/gpt-4o-prod", "api_base": "https://example.openai.azure.com", "aws_secret_access_key": "secret"},
+			 "model_info": {"id": "m-2", "db_model": false, "mode": "chat", "base_model": "azure/gpt-4o"}}`,
+			`{"model_name": "embed", "litellm_params": {"model": "openai/text-embedding-3-small"},
+			 "model_info": {"id": "m-3", "db_model": true, "mode": "embedding"}}`,
+		}
+		if id := r.URL.Query().Get("litellm_model_id"); id != "" {
+			var matching []string
+			for _, deployment := range deployments {
+				if strings.Contains(deployment, `"id": "`+id+`"`) {
+					matching = append(matching, deployment)
+				}
+			}
+			deployments = matching
+		}
+		w.Write([]byte(`{"data": [` + strings.Join(deployments, ",") + `]}`))
+	}))
+}
+
+func TestModelsDataSourceFiltersDeployments(t *testing.T) {
+	srv := modelListServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMModels().Schema, map[string]interface{}{
+		"mode": "chat",
+	})
+	if err := dataSourceLiteLLMModelsRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	models := d.Get("models").([]interface{})
+	if len(models) != 2 {
+		t.Fatalf("expected 2 chat deployments, got %d: %v", len(models), models)
+	}
+	if names := d.Get("model_names").([]interface{}); len(names) != 1 || names[0] != "gpt-4o" {
+		t.Fatalf("expected distinct model_names [gpt-4o], got %v", names)
+	}
+
+	first := models[0].(map[string]interface{})
+	if first["id"] != "m-1" || first["custom_llm_provider"] != "openai" || first["base_model"] != "gpt-4o" || first["team_id"] != "team-1" {
+		t.Fatalf("unexpected first deployment: %v", first)
+	}
+	params := first["litellm_params"].(map[string]interface{})
+	if params["rpm"] != "100" || params["input_cost_per_token"] != "2.5e-06" {
+		t.Fatalf("non-secret params not exposed: %v", params)
+	}
+	if _, ok := params["api_key"]; ok {
+		t.Fatalf("api_key exposed by data source: %v", params)
+	}
+
+	second := models[1].(map[string]interface{})
+	if second["base_model"] != "azure/gpt-4o" || second["custom_llm_provider"] != "azure" {
+		t.Fatalf("unexpected second deployment: %v", second)
+	}
+	if _, ok := second["litellm_params"].(map[string]interface{})["aws_secret_access_key"]; ok {
+		t.Fatalf("AWS secret exposed by data source")
+	}
+}
+
+func TestModelsDataSourceFiltersByDBModel(t *testing.T) {
+	srv := modelListServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMModels().Schema, map[string]interface{}{
+		"model_name": "gpt-4o",
+		"db_model":   false,
+	})
+	if err := dataSourceLiteLLMModelsRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	models := d.Get("models").([]interface{})
+	if len(models) != 1 || models[0].(map[string]interface{})["id"] != "m-2" {
+		t.Fatalf("expected only the config.yaml deployment m-2, got %v", models)
+	}
+}
@@ -0,0 +1,250 @@
+package litellm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// organizationDataSourceSchema describes an organization as exposed by the
+// litellm_organization and litellm_organizations data sources.
+func organizationDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"organization_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the organization",
+		},
+		"organization_alias": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alias of the organization",
+		},
+		"models": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Models the organization can access",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Maximum budget of the organization",
+		},
+		"soft_budget": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Soft budget of the organization",
+		},
+		"budget_duration": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Budget reset period",
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Tokens per minute limit",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Requests per minute limit",
+		},
+		"blocked": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the organization is blocked",
+		},
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Amount spent by the organization",
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Metadata of the organization. Non-string values are JSON encoded",
+		},
+		"guardrails": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Guardrails applied to the organization's requests",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Tags of the organization",
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Members of the organization",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"user_email": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"user_role": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMOrganization() *schema.Resource {
+	s := organizationDataSourceSchema()
+	for _, attr := range []string{"organization_id", "organization_alias"} {
+		s[attr].Optional = true
+		s[attr].ExactlyOneOf = []string{"organization_id", "organization_alias"}
+	}
+	s["organization_id"].Description = "ID of the organization to look up"
+	s["organization_alias"].Description = "Alias of the organization to look up. Fails if several organizations share the alias"
+
+	return &schema.Resource{
+		Read:   dataSourceLiteLLMOrganizationRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLiteLLMOrganizations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLiteLLMOrganizationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Organizations visible to the provider's API key",
+				Elem:        &schema.Resource{Schema: organizationDataSourceSchema()},
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	orgID := d.Get("organization_id").(string)
+	if alias, ok := d.GetOk("organization_alias"); ok {
+		orgs, err := ListOrganizations(client)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for _, org := range orgs {
+			if org.OrganizationAlias == alias.(string) {
+				ids = append(ids, org.OrganizationID)
+			}
+		}
+		if orgID, err = resolveImportAlias("organization", "organization_alias", alias.(string), ids); err != nil {
+			return err
+		}
+	}
+
+	org, err := getOrganizationInfo(client, orgID)
+	if err != nil {
+		return err
+	}
+	if org == nil {
+		return fmt.Errorf("organization '%s' not found", orgID)
+	}
+
+	d.SetId(org.OrganizationID)
+	for k, v := range flattenOrganization(org) {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func dataSourceLiteLLMOrganizationsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	orgs, err := ListOrganizations(client)
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0, len(orgs))
+	ids := make([]string, 0, len(orgs))
+	for i := range orgs {
+		result = append(result, flattenOrganization(&orgs[i]))
+		ids = append(ids, orgs[i].OrganizationID)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ",")))))
+	if err := d.Set("organizations", result); err != nil {
+		return fmt.Errorf("error setting organizations: %w", err)
+	}
+	return nil
+}
+
+// flattenOrganization converts an organization into the
+// organizationDataSourceSchema shape, reading budgets and limits from its
+// budget table when they are not returned as top-level fields.
+func flattenOrganization(org *OrganizationResponse) map[string]interface{} {
+	applyOrganizationBudgetTable(org)
+
+	guardrails := org.Guardrails
+	if guardrails == nil {
+		guardrails = metadataStringList(org.Metadata, "guardrails")
+	}
+	tags := org.Tags
+	if tags == nil {
+		tags = metadataStringList(org.Metadata, "tags")
+	}
+
+	members := make([]interface{}, 0, len(org.Members))
+	for _, member := range org.Members {
+		email := ""
+		if member.User != nil {
+			email = member.User.UserEmail
+		}
+		members = append(members, map[string]interface{}{
+			"user_id":    member.UserID,
+			"user_email": email,
+			"user_role":  member.UserRole,
+		})
+	}
+
+	result := map[string]interface{}{
+		"organization_id":    org.OrganizationID,
+		"organization_alias": org.OrganizationAlias,
+		"models":             org.Models,
+		"budget_duration":    org.BudgetDuration,
+		"blocked":            org.Blocked,
+		"spend":              org.Spend,
+		"metadata":           stringifyMetadata(stripReservedMetadata(org.Metadata, organizationReservedMetadataKeys...)),
+		"guardrails":         guardrails,
+		"tags":               tags,
+		"members":            members,
+	}
+	if org.MaxBudget != nil {
+		result["max_budget"] = *org.MaxBudget
+	}
+	if org.SoftBudget != nil {
+		result["soft_budget"] = *org.SoftBudget
+	}
+	if org.TPMLimit != nil {
+		result["tpm_limit"] = *org.TPMLimit
+	}
+	if org.RPMLimit != nil {
+		result["rpm_limit"] = *org.RPMLimit
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package litellm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testOrganizationJSON = `{
+	"organization_id": "org-1",
+	"organization_alias": "acme",
+	"models": ["gpt-4o"],
+	"spend": 3.25,
+	"litellm_budget_table": {"max_budget": 500, "rpm_limit": 60, "budget_duration": "30d"},
+	"members": [{"user_id": "u-1", "user_role": "org_admin", "user": {"user_email": "a@example.com"}}]
+}`
+
+func organizationDataSourceServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/organization/list":
+			w.Write([]byte(`[` + testOrganizationJSON + `, {"organization_id": "org-2", "organization_alias": "other"}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/organization/info":
+			w.Write([]byte(`[` + testOrganizationJSON + `]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestOrganizationDataSourceLooksUpByAlias(t *testing.T) {
+	srv := organizationDataSourceServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMOrganization().Schema, map[string]interface{}{
+		"organization_alias": "acme",
+	})
+	if err := dataSourceLiteLLMOrganizationRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if d.Id() != "org-1" || d.Get("organization_id").(string) != "org-1" {
+		t.Fatalf("unexpected organization ID %q", d.Id())
+	}
+	if d.Get("max_budget").(float64) != 500 || d.Get("rpm_limit").(int) != 60 || d.Get("budget_duration").(string) != "30d" {
+		t.Fatalf("budget table not exposed: max_budget=%v rpm_limit=%v", d.Get("max_budget"), d.Get("rpm_limit"))
+	}
+	if d.Get("spend").(float64) != 3.25 {
+		t.Fatalf("spend not exposed: %v", d.Get("spend"))
+	}
+	if d.Get("members.0.user_email").(string) != "a@example.com" || d.Get("members.0.user_role").(string) != "org_admin" {
+		t.Fatalf("members not exposed: %v", d.Get("members"))
+	}
+}
+
+func TestOrganizationsDataSourceListsAll(t *testing.T) {
+	srv := organizationDataSourceServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMOrganizations().Schema, map[string]interface{}{})
+	if err := dataSourceLiteLLMOrganizationsRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if orgs := d.Get("organizations").([]interface{}); len(orgs) != 2 {
+		t.Fatalf("expected 2 organizations, got %v", orgs)
+	}
+	if d.Get("organizations.0.max_budget").(float64) != 500 || d.Get("organizations.1.organization_alias").(string) != "other" {
+		t.Fatalf("unexpected organizations: %v", d.Get("organizations"))
+	}
+}
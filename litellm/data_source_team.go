@@ -0,0 +1,266 @@
+package litellm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// teamDataSourceSchema describes a team as exposed by the litellm_team and
+// litellm_teams data sources.
+func teamDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"team_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the team",
+		},
+		"team_alias": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alias of the team",
+		},
+		"organization_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Organization the team belongs to",
+		},
+		"models": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Models the team can access",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Maximum budget of the team",
+		},
+		"soft_budget": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Soft budget of the team",
+		},
+		"budget_duration": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Budget reset period",
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Tokens per minute limit",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Requests per minute limit",
+		},
+		"team_member_budget": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Default budget of each team member",
+		},
+		"blocked": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the team is blocked",
+		},
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Amount spent by the team",
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Metadata of the team. Non-string values are JSON encoded",
+		},
+		"guardrails": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Guardrails applied to the team's requests",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Tags of the team",
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Members of the team",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"user_email": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"role": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMTeam() *schema.Resource {
+	s := teamDataSourceSchema()
+	for _, attr := range []string{"team_id", "team_alias"} {
+		s[attr].Optional = true
+		s[attr].ExactlyOneOf = []string{"team_id", "team_alias"}
+	}
+	s["team_id"].Description = "ID of the team to look up"
+	s["team_alias"].Description = "Alias of the team to look up. Fails if several teams share the alias"
+
+	return &schema.Resource{
+		Read:   dataSourceLiteLLMTeamRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLiteLLMTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLiteLLMTeamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return teams belonging to this organization",
+			},
+			"teams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Teams visible to the provider's API key",
+				Elem:        &schema.Resource{Schema: teamDataSourceSchema()},
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMTeamRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	teamID := d.Get("team_id").(string)
+	if alias, ok := d.GetOk("team_alias"); ok {
+		teams, err := ListTeams(client)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for _, team := range teams {
+			if team.TeamAlias == alias.(string) {
+				ids = append(ids, team.TeamID)
+			}
+		}
+		if teamID, err = resolveImportAlias("team", "team_alias", alias.(string), ids); err != nil {
+			return err
+		}
+	}
+
+	team, err := getTeamInfo(client, teamID)
+	if err != nil {
+		return err
+	}
+	if team == nil {
+		return fmt.Errorf("team '%s' not found", teamID)
+	}
+
+	d.SetId(team.TeamID)
+	for k, v := range flattenTeam(team) {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func dataSourceLiteLLMTeamsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	teams, err := ListTeams(client)
+	if err != nil {
+		return err
+	}
+
+	orgID := d.Get("organization_id").(string)
+	var result []interface{}
+	var ids []string
+	for i := range teams {
+		if orgID != "" && teams[i].OrganizationID != orgID {
+			continue
+		}
+		result = append(result, flattenTeam(&teams[i]))
+		ids = append(ids, teams[i].TeamID)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ",")))))
+	if err := d.Set("teams", result); err != nil {
+		return fmt.Errorf("error setting teams: %w", err)
+	}
+	return nil
+}
+
+// flattenTeam converts a team into the teamDataSourceSchema shape. Guardrails
+// and tags fall back to the team metadata, where the proxy persists them.
+func flattenTeam(team *TeamResponse) map[string]interface{} {
+	guardrails := team.Guardrails
+	if guardrails == nil {
+		guardrails = metadataStringList(team.Metadata, "guardrails")
+	}
+	tags := team.Tags
+	if tags == nil {
+		tags = metadataStringList(team.Metadata, "tags")
+	}
+
+	members := make([]interface{}, 0, len(team.MembersWithRoles))
+	for _, member := range team.MembersWithRoles {
+		members = append(members, map[string]interface{}{
+			"user_id":    member.UserID,
+			"user_email": member.UserEmail,
+			"role":       member.Role,
+		})
+	}
+
+	result := map[string]interface{}{
+		"team_id":         team.TeamID,
+		"team_alias":      team.TeamAlias,
+		"organization_id": team.OrganizationID,
+		"models":          team.Models,
+		"budget_duration": team.BudgetDuration,
+		"blocked":         team.Blocked,
+		"spend":           team.Spend,
+		"metadata":        stringifyMetadata(stripReservedMetadata(team.Metadata, teamReservedMetadataKeys...)),
+		"guardrails":      guardrails,
+		"tags":            tags,
+		"members":         members,
+	}
+	if team.MaxBudget != nil {
+		result["max_budget"] = *team.MaxBudget
+	}
+	if team.SoftBudget != nil {
+		result["soft_budget"] = *team.SoftBudget
+	}
+	if team.TPMLimit != nil {
+		result["tpm_limit"] = *team.TPMLimit
+	}
+	if team.RPMLimit != nil {
+		result["rpm_limit"] = *team.RPMLimit
+	}
+	if team.TeamMemberBudget != nil {
+		result["team_member_budget"] = *team.TeamMemberBudget
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package litellm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func teamDataSourceServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/team/list":
+			w.Write([]byte(`[
+				{"team_id": "team-1", "team_alias": "platform", "organization_id": "org-1", "spend": 12.5},
+				{"team_id": "team-2", "team_alias": "shared", "organization_id": "org-2"},
+				{"team_id": "team-3", "team_alias": "shared", "organization_id": "org-1"}
+			]`))
+		case "/team/info":
+			if r.URL.Query().Get("team_id") != "team-1" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"detail": "team not found"}`))
+				return
+			}
+			w.Write([]byte(`{"team_id": "team-1", "team_info": {
+				"team_id": "team-1",
+				"team_alias": "platform",
+				"organization_id": "org-1",
+				"models": ["gpt-4o"],
+				"max_budget": 100,
+				"tpm_limit": 1000,
+				"spend": 12.5,
+				"metadata": {"owner": "infra", "cost_center": 42, "tags": ["prod"]},
+				"members_with_roles": [{"user_id": "u-1", "user_email": "a@example.com", "role": "admin"}]
+			}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestTeamDataSourceLooksUpByAlias(t *testing.T) {
+	srv := teamDataSourceServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMTeam().Schema, map[string]interface{}{
+		"team_alias": "platform",
+	})
+	if err := dataSourceLiteLLMTeamRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if d.Id() != "team-1" || d.Get("team_id").(string) != "team-1" || d.Get("organization_id").(string) != "org-1" {
+		t.Fatalf("unexpected team: id=%q org=%q", d.Id(), d.Get("organization_id"))
+	}
+	if d.Get("max_budget").(float64) != 100 || d.Get("tpm_limit").(int) != 1000 || d.Get("spend").(float64) != 12.5 {
+		t.Fatalf("budgets, limits or spend not exposed")
+	}
+	if tags := d.Get("tags").([]interface{}); len(tags) != 1 || tags[0] != "prod" {
+		t.Fatalf("tags not read from metadata: %v", tags)
+	}
+	metadata := d.Get("metadata").(map[string]interface{})
+	if metadata["cost_center"] != "42" || metadata["owner"] != "infra" || metadata["tags"] != nil {
+		t.Fatalf("unexpected metadata: %v", metadata)
+	}
+	if d.Get("members.0.user_email").(string) != "a@example.com" || d.Get("members.0.role").(string) != "admin" {
+		t.Fatalf("members not exposed: %v", d.Get("members"))
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceLiteLLMTeam().Schema, map[string]interface{}{
+		"team_alias": "shared",
+	})
+	err := dataSourceLiteLLMTeamRead(d, client)
+	if err == nil || !strings.Contains(err.Error(), "team-2, team-3") {
+		t.Fatalf("expected an ambiguity error listing both teams, got %v", err)
+	}
+}
+
+func TestTeamDataSourceFailsForMissingTeam(t *testing.T) {
+	srv := teamDataSourceServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMTeam().Schema, map[string]interface{}{
+		"team_id": "team-9",
+	})
+	if err := dataSourceLiteLLMTeamRead(d, client); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestTeamsDataSourceFiltersByOrganization(t *testing.T) {
+	srv := teamDataSourceServer(t)
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceLiteLLMTeams().Schema, map[string]interface{}{
+		"organization_id": "org-1",
+	})
+	if err := dataSourceLiteLLMTeamsRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	teams := d.Get("teams").([]interface{})
+	if len(teams) != 2 {
+		t.Fatalf("expected 2 teams in org-1, got %v", teams)
+	}
+	if d.Get("teams.0.team_id").(string) != "team-1" || d.Get("teams.0.spend").(float64) != 12.5 || d.Get("teams.1.team_id").(string) != "team-3" {
+		t.Fatalf("unexpected teams: %v", teams)
+	}
+}
@@ -0,0 +1,194 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultEphemeralKeyDuration bounds the lifetime of an ephemeral key in case
+// Terraform never gets to close it.
+const defaultEphemeralKeyDuration = "1h"
+
+// ephemeralKeyPrivateTokenID is the private data key holding the token ID
+// revoked on Close.
+const ephemeralKeyPrivateTokenID = "token_id"
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralKey{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralKey{}
+)
+
+type ephemeralKey struct {
+	client *Client
+}
+
+type ephemeralKeyModel struct {
+	KeyAlias  types.String  `tfsdk:"key_alias"`
+	Duration  types.String  `tfsdk:"duration"`
+	Models    types.List    `tfsdk:"models"`
+	MaxBudget types.Float64 `tfsdk:"max_budget"`
+	UserID    types.String  `tfsdk:"user_id"`
+	TeamID    types.String  `tfsdk:"team_id"`
+	Metadata  types.Map     `tfsdk:"metadata"`
+	Key       types.String  `tfsdk:"key"`
+	TokenID   types.String  `tfsdk:"token_id"`
+	Expires   types.String  `tfsdk:"expires"`
+}
+
+func newEphemeralKey() ephemeral.EphemeralResource {
+	return &ephemeralKey{}
+}
+
+func (e *ephemeralKey) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (e *ephemeralKey) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Short-lived LiteLLM key that is generated on open, revoked on close and never stored in state or plan",
+		Attributes: map[string]schema.Attribute{
+			"key_alias": schema.StringAttribute{
+				Optional:    true,
+				Description: "Alias of the key",
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lifetime of the key, e.g. 30m or 1h. Defaults to 1h so the key expires even if it is never revoked",
+			},
+			"models": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Models the key can access",
+			},
+			"max_budget": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum budget of the key",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "User the key belongs to",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Team the key belongs to",
+			},
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Metadata of the key",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated key",
+			},
+			"token_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the key, used as its ID",
+			},
+			"expires": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry timestamp of the key",
+			},
+		},
+	}
+}
+
+func (e *ephemeralKey) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *litellm.Client, got %T", req.ProviderData))
+		return
+	}
+	e.client = client
+}
+
+func (e *ephemeralKey) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := &Key{
+		KeyAlias: data.KeyAlias.ValueString(),
+		Duration: data.Duration.ValueString(),
+		UserID:   data.UserID.ValueString(),
+		TeamID:   data.TeamID.ValueString(),
+		Models:   []string{},
+	}
+	if key.Duration == "" {
+		key.Duration = defaultEphemeralKeyDuration
+	}
+	if !data.Models.IsNull() {
+		resp.Diagnostics.Append(data.Models.ElementsAs(ctx, &key.Models, false)...)
+	}
+	if !data.MaxBudget.IsNull() {
+		maxBudget := data.MaxBudget.ValueFloat64()
+		key.MaxBudget = &maxBudget
+	}
+	if !data.Metadata.IsNull() {
+		metadata := map[string]string{}
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+		key.Metadata = make(map[string]interface{}, len(metadata))
+		for k, v := range metadata {
+			key.Metadata[k] = v
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := e.client.CreateKey(key)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating ephemeral key", err.Error())
+		return
+	}
+	tokenID := created.TokenID
+	if tokenID == "" {
+		tokenID = hashKey(created.Key)
+	}
+	log.Printf("[INFO] Generated ephemeral key %s", tokenID)
+
+	data.Duration = types.StringValue(key.Duration)
+	data.Key = types.StringValue(created.Key)
+	data.TokenID = types.StringValue(tokenID)
+	data.Expires = types.StringValue(created.Expires)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	private, err := json.Marshal(tokenID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error storing ephemeral key ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralKeyPrivateTokenID, private)...)
+}
+
+func (e *ephemeralKey) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, ephemeralKeyPrivateTokenID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var tokenID string
+	if err := json.Unmarshal(raw, &tokenID); err != nil {
+		resp.Diagnostics.AddError("Error reading ephemeral key ID", err.Error())
+		return
+	}
+
+	if err := e.client.DeleteKey(tokenID); err != nil {
+		resp.Diagnostics.AddError("Error revoking ephemeral key", err.Error())
+		return
+	}
+	log.Printf("[INFO] Revoked ephemeral key %s", tokenID)
+}
@@ -0,0 +1,134 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderServerMuxesSchemas(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatalf("failed to build provider server: %v", err)
+	}
+
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected schema error: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+	if _, ok := resp.ResourceSchemas["litellm_key"]; !ok {
+		t.Fatalf("litellm_key resource missing from muxed schema")
+	}
+	if _, ok := resp.EphemeralResourceSchemas["litellm_key"]; !ok {
+		t.Fatalf("litellm_key ephemeral resource missing from muxed schema")
+	}
+}
+
+func TestEphemeralKeyOpenAndClose(t *testing.T) {
+	var generated map[string]interface{}
+	var deleted []interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.URL.Path {
+		case "/key/generate":
+			generated = body
+			w.Write([]byte(`{"key": "sk-ephemeral", "token_id": "tok-1", "expires": "2026-01-01T01:00:00Z"}`))
+		case "/key/delete":
+			deleted = body["keys"].([]interface{})
+			w.Write([]byte(`{"deleted_keys": ["tok-1"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	serverFactory, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatalf("failed to build provider server: %v", err)
+	}
+	server := serverFactory()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+
+	providerType := schemaResp.Provider.ValueType()
+	providerConfig, err := tfprotov5.NewDynamicValue(providerType, tftypes.NewValue(providerType, map[string]tftypes.Value{
+		"api_base":             tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":              tftypes.NewValue(tftypes.String, "test-key"),
+		"insecure_skip_verify": tftypes.NewValue(tftypes.Bool, nil),
+	}))
+	if err != nil {
+		t.Fatalf("failed to encode provider config: %v", err)
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, configureResp.Diagnostics)
+	}
+
+	keyType := schemaResp.EphemeralResourceSchemas["litellm_key"].ValueType()
+	keyConfig, err := tfprotov5.NewDynamicValue(keyType, tftypes.NewValue(keyType, map[string]tftypes.Value{
+		"key_alias":  tftypes.NewValue(tftypes.String, "ci-job"),
+		"duration":   tftypes.NewValue(tftypes.String, nil),
+		"models":     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "gpt-4o")}),
+		"max_budget": tftypes.NewValue(tftypes.Number, 5),
+		"user_id":    tftypes.NewValue(tftypes.String, nil),
+		"team_id":    tftypes.NewValue(tftypes.String, "team-1"),
+		"metadata":   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"key":        tftypes.NewValue(tftypes.String, nil),
+		"token_id":   tftypes.NewValue(tftypes.String, nil),
+		"expires":    tftypes.NewValue(tftypes.String, nil),
+	}))
+	if err != nil {
+		t.Fatalf("failed to encode key config: %v", err)
+	}
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "litellm_key",
+		Config:   &keyConfig,
+	})
+	if err != nil || len(openResp.Diagnostics) > 0 {
+		t.Fatalf("OpenEphemeralResource failed: %v %v", err, openResp.Diagnostics)
+	}
+	if generated["duration"] != defaultEphemeralKeyDuration || generated["team_id"] != "team-1" || generated["max_budget"] != float64(5) {
+		t.Fatalf("unexpected /key/generate body: %v", generated)
+	}
+
+	result, err := openResp.Result.Unmarshal(keyType)
+	if err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	result.As(&attrs)
+	var key, tokenID string
+	attrs["key"].As(&key)
+	attrs["token_id"].As(&tokenID)
+	if key != "sk-ephemeral" || tokenID != "tok-1" {
+		t.Fatalf("unexpected result key=%q token_id=%q", key, tokenID)
+	}
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "litellm_key",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("CloseEphemeralResource failed: %v %v", err, closeResp.Diagnostics)
+	}
+	if len(deleted) != 1 || deleted[0] != "tok-1" {
+		t.Fatalf("expected tok-1 to be revoked, got %v", deleted)
+	}
+}
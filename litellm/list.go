@@ -0,0 +1,194 @@
+package litellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	endpointKeyList         = "/key/list"
+	endpointCredentialList  = "/credentials"
+	endpointGuardrailList   = "/guardrails/list"
+	endpointVectorStoreList = "/vector_store/list"
+)
+
+// ListTeams returns every team visible to the client's API key.
+func ListTeams(client *Client) ([]TeamResponse, error) {
+	resp, err := MakeRequest(client, "GET", endpointTeamList, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing teams: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing teams"); err != nil {
+		return nil, err
+	}
+
+	var teams []TeamResponse
+	if err := json.NewDecoder(resp.Body).Decode(&teams); err != nil {
+		return nil, fmt.Errorf("error decoding team list response: %w", err)
+	}
+	return teams, nil
+}
+
+// ListOrganizations returns every organization visible to the client's API key.
+func ListOrganizations(client *Client) ([]OrganizationResponse, error) {
+	resp, err := MakeRequest(client, "GET", endpointOrganizationList, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing organizations: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing organizations"); err != nil {
+		return nil, err
+	}
+
+	var orgs []OrganizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&orgs); err != nil {
+		return nil, fmt.Errorf("error decoding organization list response: %w", err)
+	}
+	return orgs, nil
+}
+
+// ListModels returns every model deployment configured on the proxy.
+func ListModels(client *Client) ([]ModelResponse, error) {
+	resp, err := MakeRequest(client, "GET", endpointModelInfo, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing models"); err != nil {
+		return nil, err
+	}
+
+	var models ModelListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&models); err != nil {
+		return nil, fmt.Errorf("failed to parse model list response: %w", err)
+	}
+	return models.Data, nil
+}
+
+// ListMCPServers returns every MCP server registered on the proxy.
+func ListMCPServers(client *Client) ([]MCPServerResponse, error) {
+	resp, err := MakeRequest(client, "GET", endpointMCPServerRead, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var servers []MCPServerResponse
+	if err := handleMCPAPIResponse(resp, &servers, client); err != nil {
+		return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+	return servers, nil
+}
+
+// ListVectorStores returns every vector store on the proxy, walking all pages
+// of /vector_store/list.
+func ListVectorStores(client *Client) ([]VectorStoreResponse, error) {
+	var vectorStores []VectorStoreResponse
+	for page := 1; ; page++ {
+		resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?page=%d&page_size=100", endpointVectorStoreList, page), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list vector stores: %w", err)
+		}
+
+		var listResp VectorStoreListResponse
+		err = handleVectorStoreAPIResponse(resp, &listResp, client)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list vector stores: %w", err)
+		}
+
+		vectorStores = append(vectorStores, listResp.Data...)
+		if len(listResp.Data) == 0 || page >= listResp.TotalPages {
+			return vectorStores, nil
+		}
+	}
+}
+
+// ListKeys returns every key visible to the client's API key, walking all
+// pages of /key/list. filters are passed to /key/list as query parameters
+// (team_id, user_id, organization_id, key_alias). TokenID holds the hashed
+// token used as the key's ID.
+func ListKeys(client *Client, filters url.Values) ([]Key, error) {
+	query := ""
+	if len(filters) > 0 {
+		query = "&" + filters.Encode()
+	}
+
+	var keys []Key
+	for page := 1; ; page++ {
+		resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?return_full_object=true&page=%d&size=100%s", endpointKeyList, page, query), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error listing keys: %w", err)
+		}
+
+		var listResp struct {
+			Keys       []map[string]interface{} `json:"keys"`
+			TotalPages int                      `json:"total_pages"`
+		}
+		err = handleResponse(resp, "listing keys")
+		if err == nil {
+			if decodeErr := json.NewDecoder(resp.Body).Decode(&listResp); decodeErr != nil {
+				err = fmt.Errorf("error decoding key list response: %w", decodeErr)
+			}
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, raw := range listResp.Keys {
+			key, err := client.parseKeyResponse(raw)
+			if err != nil {
+				return nil, err
+			}
+			if token, ok := raw["token"].(string); ok && key.TokenID == "" {
+				key.TokenID = token
+			}
+			keys = append(keys, *key)
+		}
+
+		if len(listResp.Keys) == 0 || page >= listResp.TotalPages {
+			return keys, nil
+		}
+	}
+}
+
+// ListCredentials returns every credential stored on the proxy. Credential
+// values are masked by the proxy.
+func ListCredentials(client *Client) ([]CredentialResponse, error) {
+	resp, err := MakeRequest(client, "GET", endpointCredentialList, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var listResp struct {
+		Credentials []CredentialResponse `json:"credentials"`
+	}
+	if err := handleCredentialAPIResponse(resp, &listResp, client); err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	return listResp.Credentials, nil
+}
+
+// ListGuardrails returns every guardrail configured on the proxy.
+func ListGuardrails(client *Client) ([]GuardrailResponse, error) {
+	resp, err := MakeRequest(client, "GET", endpointGuardrailList, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guardrails: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var listResp struct {
+		Guardrails []GuardrailResponse `json:"guardrails"`
+	}
+	if err := handleGuardrailAPIResponse(resp, &listResp, client); err != nil {
+		return nil, fmt.Errorf("failed to list guardrails: %w", err)
+	}
+	return listResp.Guardrails, nil
+}
@@ -0,0 +1,222 @@
+package litellm
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// modelInfoSchema returns the model_info block of litellm_model. The proxy
+// fills unset fields from its model cost map, so every field is also
+// computed and reported without showing up as drift.
+func modelInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Capability and pricing metadata the proxy uses for routing and cost tracking",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_tokens": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Computed:    true,
+					Description: "Maximum number of tokens the model handles",
+				},
+				"max_input_tokens": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Computed:    true,
+					Description: "Maximum number of input tokens (context window)",
+				},
+				"max_output_tokens": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Computed:    true,
+					Description: "Maximum number of output tokens",
+				},
+				"supports_vision": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether the model accepts image input",
+				},
+				"supports_function_calling": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether the model supports function calling",
+				},
+				"supports_prompt_caching": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether the model supports prompt caching",
+				},
+				"access_groups": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Access groups the model belongs to. Keys and teams can be granted a group instead of individual models",
+				},
+				"cache_read_input_token_cost": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cost per input token read from the prompt cache",
+				},
+				"cache_creation_input_token_cost": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cost per input token written to the prompt cache",
+				},
+				"input_cost_per_token_above_128k_tokens": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cost per input token once the prompt exceeds 128k tokens",
+				},
+				"output_cost_per_token_above_128k_tokens": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cost per output token once the prompt exceeds 128k tokens",
+				},
+				"input_cost_per_token_above_200k_tokens": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cost per input token once the prompt exceeds 200k tokens",
+				},
+				"output_cost_per_token_above_200k_tokens": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cost per output token once the prompt exceeds 200k tokens",
+				},
+			},
+		},
+	}
+}
+
+// expandModelInfo copies the model_info fields set in configuration onto
+// info. Fields left unset are omitted so the proxy keeps using its model cost
+// map for them.
+func expandModelInfo(d *schema.ResourceData, info *ModelInfo) {
+	configured := modelInfoConfigured(d)
+	if configured == nil {
+		return
+	}
+
+	intField := func(attr string) *int {
+		if configured(attr) {
+			n := d.Get("model_info.0." + attr).(int)
+			return &n
+		}
+		return nil
+	}
+	boolField := func(attr string) *bool {
+		if configured(attr) {
+			b := d.Get("model_info.0." + attr).(bool)
+			return &b
+		}
+		return nil
+	}
+	floatField := func(attr string) *float64 {
+		if configured(attr) {
+			f := d.Get("model_info.0." + attr).(float64)
+			return &f
+		}
+		return nil
+	}
+
+	info.MaxTokens = intField("max_tokens")
+	info.MaxInputTokens = intField("max_input_tokens")
+	info.MaxOutputTokens = intField("max_output_tokens")
+	info.SupportsVision = boolField("supports_vision")
+	info.SupportsFunctionCalling = boolField("supports_function_calling")
+	info.SupportsPromptCaching = boolField("supports_prompt_caching")
+	if configured("access_groups") {
+		info.AccessGroups = expandStringList(d.Get("model_info.0.access_groups").([]interface{}))
+	}
+	info.CacheReadInputTokenCost = floatField("cache_read_input_token_cost")
+	info.CacheCreationInputTokenCost = floatField("cache_creation_input_token_cost")
+	info.InputCostPerTokenAbove128kTokens = floatField("input_cost_per_token_above_128k_tokens")
+	info.OutputCostPerTokenAbove128kTokens = floatField("output_cost_per_token_above_128k_tokens")
+	info.InputCostPerTokenAbove200kTokens = floatField("input_cost_per_token_above_200k_tokens")
+	info.OutputCostPerTokenAbove200kTokens = floatField("output_cost_per_token_above_200k_tokens")
+}
+
+// modelInfoConfigured returns a function reporting whether a model_info
+// field is set in configuration, or nil when the block is not configured.
+// model_info is computed, so d also holds the cost-map values the proxy
+// reported, which must not be sent back. Without a raw configuration (in
+// legacy tests) d holds only the configuration.
+func modelInfoConfigured(d *schema.ResourceData) func(attr string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		if _, ok := d.GetOk("model_info"); !ok {
+			return nil
+		}
+		return func(attr string) bool {
+			_, ok := d.GetOkExists("model_info.0." + attr)
+			return ok
+		}
+	}
+
+	block := config.GetAttr("model_info")
+	if !block.IsKnown() || block.IsNull() || block.LengthInt() == 0 {
+		return nil
+	}
+	fields := block.Index(cty.NumberIntVal(0))
+	return func(attr string) bool {
+		v := fields.GetAttr(attr)
+		return v.IsKnown() && !v.IsNull()
+	}
+}
+
+// flattenModelInfo converts the model_info returned by /model/info into the
+// model_info block. It returns nil when the proxy reports none of its fields.
+func flattenModelInfo(info ModelInfo) []interface{} {
+	result := make(map[string]interface{})
+	for attr, v := range map[string]*int{
+		"max_tokens":        info.MaxTokens,
+		"max_input_tokens":  info.MaxInputTokens,
+		"max_output_tokens": info.MaxOutputTokens,
+	} {
+		if v != nil {
+			result[attr] = *v
+		}
+	}
+	for attr, v := range map[string]*bool{
+		"supports_vision":           info.SupportsVision,
+		"supports_function_calling": info.SupportsFunctionCalling,
+		"supports_prompt_caching":   info.SupportsPromptCaching,
+	} {
+		if v != nil {
+			result[attr] = *v
+		}
+	}
+	for attr, v := range map[string]*float64{
+		"cache_read_input_token_cost":             info.CacheReadInputTokenCost,
+		"cache_creation_input_token_cost":         info.CacheCreationInputTokenCost,
+		"input_cost_per_token_above_128k_tokens":  info.InputCostPerTokenAbove128kTokens,
+		"output_cost_per_token_above_128k_tokens": info.OutputCostPerTokenAbove128kTokens,
+		"input_cost_per_token_above_200k_tokens":  info.InputCostPerTokenAbove200kTokens,
+		"output_cost_per_token_above_200k_tokens": info.OutputCostPerTokenAbove200kTokens,
+	} {
+		if v != nil {
+			result[attr] = *v
+		}
+	}
+	if info.AccessGroups != nil {
+		result["access_groups"] = info.AccessGroups
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	return []interface{}{result}
+}
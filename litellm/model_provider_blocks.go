@@ -0,0 +1,362 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// modelProviderField is an attribute of a provider block on litellm_model.
+type modelProviderField struct {
+	name        string
+	param       string // litellm_params key the field is sent as
+	flat        string // top-level attribute the field replaces or mirrors
+	required    bool
+	sensitive   bool
+	description string
+}
+
+// modelProviderBlock describes one of the aws, azure, vertex and
+// openai_compatible blocks of litellm_model.
+type modelProviderBlock struct {
+	name string
+	// providers lists the custom_llm_provider values the block applies to.
+	// An empty list means every provider not claimed by another block.
+	providers []string
+	// deprecatedFlat is set when the block replaces provider-specific flat
+	// attributes, which are then deprecated and rejected for other providers.
+	deprecatedFlat bool
+	fields         []modelProviderField
+}
+
+var modelProviderBlocks = []modelProviderBlock{
+	{
+		name:           "aws",
+		providers:      []string{"bedrock", "sagemaker", "sagemaker_chat"},
+		deprecatedFlat: true,
+		fields: []modelProviderField{
+			{name: "region_name", param: "aws_region_name", flat: "aws_region_name", required: true, description: "AWS region of the model"},
+			{name: "access_key_id", param: "aws_access_key_id", flat: "aws_access_key_id", sensitive: true, description: "AWS access key ID. Omit to use the proxy's AWS credentials"},
+			{name: "secret_access_key", param: "aws_secret_access_key", flat: "aws_secret_access_key", sensitive: true, description: "AWS secret access key"},
+			{name: "session_name", param: "aws_session_name", flat: "aws_session_name", description: "Session name used when assuming role_name"},
+			{name: "role_name", param: "aws_role_name", flat: "aws_role_name", description: "IAM role to assume for cross-account access"},
+		},
+	},
+	{
+		name:      "azure",
+		providers: []string{"azure", "azure_ai", "azure_text"},
+		fields: []modelProviderField{
+			{name: "api_base", param: "api_base", flat: "model_api_base", required: true, description: "Azure endpoint, e.g. https://my-resource.openai.azure.com"},
+			{name: "api_version", param: "api_version", flat: "api_version", description: "Azure OpenAI API version"},
+			{name: "api_key", param: "api_key", flat: "model_api_key", sensitive: true, description: "Azure API key. Omit to use the proxy's Azure credentials"},
+		},
+	},
+	{
+		name:           "vertex",
+		providers:      []string{"vertex_ai", "vertex_ai_beta"},
+		deprecatedFlat: true,
+		fields: []modelProviderField{
+			{name: "project", param: "vertex_project", flat: "vertex_project", required: true, description: "Google Cloud project ID"},
+			{name: "location", param: "vertex_location", flat: "vertex_location", required: true, description: "Vertex AI location, e.g. us-central1"},
+			{name: "credentials", param: "vertex_credentials", flat: "vertex_credentials", sensitive: true, description: "Service account credentials JSON, or a path to it on the proxy"},
+		},
+	},
+	{
+		name: "openai_compatible",
+		fields: []modelProviderField{
+			{name: "api_base", param: "api_base", flat: "model_api_base", required: true, description: "Base URL of the OpenAI-compatible API"},
+			{name: "api_key", param: "api_key", flat: "model_api_key", sensitive: true, description: "API key of the OpenAI-compatible API"},
+		},
+	},
+}
+
+// modelProviderBlockSchemas returns the schemas of the provider blocks.
+// Required fields are checked by resourceLiteLLMModelCustomizeDiff so the
+// error can name the provider. Sensitive fields get a write-only <name>_wo
+// variant and a <name>_wo_version that resends it.
+func modelProviderBlockSchemas() map[string]*schema.Schema {
+	schemas := make(map[string]*schema.Schema, len(modelProviderBlocks))
+	for _, block := range modelProviderBlocks {
+		fields := make(map[string]*schema.Schema, len(block.fields))
+		for _, field := range block.fields {
+			fields[field.name] = &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   field.sensitive,
+				Description: field.description,
+			}
+			if field.sensitive {
+				fields[field.name+"_wo"] = &schema.Schema{
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					WriteOnly:     true,
+					ConflictsWith: []string{fmt.Sprintf("%s.0.%s", block.name, field.name)},
+					Description:   fmt.Sprintf("Write-only %s: sent to the proxy but never stored in state", field.name),
+				}
+				fields[field.name+"_wo_version"] = &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: fmt.Sprintf("Change this value to resend %s_wo to the proxy", field.name),
+				}
+			}
+		}
+
+		description := fmt.Sprintf("Settings for %s models", strings.Join(block.providers, ", "))
+		if len(block.providers) == 0 {
+			description = "Settings for OpenAI-compatible APIs. Applies to providers without a dedicated block"
+		}
+		schemas[block.name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: description,
+			Elem:        &schema.Resource{Schema: fields},
+		}
+		if block.deprecatedFlat {
+			schemas[block.name].DiffSuppressFunc = suppressUpgradedProviderBlockDiff
+		}
+	}
+	return schemas
+}
+
+// ModelProviderFamily returns the name of the litellm_model provider block
+// (aws, azure, vertex or openai_compatible) that applies to a
+// custom_llm_provider.
+func ModelProviderFamily(provider string) string {
+	for _, block := range modelProviderBlocks {
+		for _, p := range block.providers {
+			if p == provider {
+				return block.name
+			}
+		}
+	}
+	return "openai_compatible"
+}
+
+// resourceLiteLLMModelCustomizeDiff rejects provider blocks and deprecated
+// provider-specific attributes that do not apply to custom_llm_provider, and
+// checks the required fields of the block that does. For aws and vertex the
+// required fields may also come from the deprecated attributes.
+func resourceLiteLLMModelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("custom_llm_provider") {
+		return nil
+	}
+	provider := d.Get("custom_llm_provider").(string)
+	family := ModelProviderFamily(provider)
+
+	for _, block := range modelProviderBlocks {
+		configured := len(d.Get(block.name).([]interface{})) > 0
+
+		if block.name != family {
+			if configured {
+				return fmt.Errorf("the %s block cannot be used with custom_llm_provider %q, use the %s block instead", block.name, provider, family)
+			}
+			if block.deprecatedFlat {
+				for _, field := range block.fields {
+					if v, ok := d.GetOk(field.flat); ok && v.(string) != "" {
+						return fmt.Errorf("%s is not used by custom_llm_provider %q", field.flat, provider)
+					}
+				}
+			}
+			continue
+		}
+
+		if !configured {
+			// Families with deprecated attributes need their required fields
+			// from the block or from those attributes.
+			if !block.deprecatedFlat {
+				continue
+			}
+			for _, field := range block.fields {
+				if field.required && d.NewValueKnown(field.flat) && d.Get(field.flat).(string) == "" {
+					return fmt.Errorf("%s.%s is required for custom_llm_provider %q", block.name, field.name, provider)
+				}
+			}
+			continue
+		}
+		for _, field := range block.fields {
+			attr := fmt.Sprintf("%s.0.%s", block.name, field.name)
+			if field.required && d.NewValueKnown(attr) && d.Get(attr).(string) == "" {
+				return fmt.Errorf("%s.%s is required for custom_llm_provider %q", block.name, field.name, provider)
+			}
+		}
+	}
+	return nil
+}
+
+// expandModelProviderBlocks adds the configured provider block fields to
+// litellm_params.
+func expandModelProviderBlocks(d *schema.ResourceData, litellmParams map[string]interface{}) {
+	for _, block := range modelProviderBlocks {
+		items, ok := d.Get(block.name).([]interface{})
+		if !ok || len(items) == 0 || items[0] == nil {
+			continue
+		}
+		values := items[0].(map[string]interface{})
+		for _, field := range block.fields {
+			if v, _ := values[field.name].(string); v != "" {
+				litellmParams[field.param] = v
+			}
+		}
+	}
+}
+
+// setModelProviderBlocks reads the provider settings back from /model/info.
+// Blocks already in state are refreshed, and models without state for their
+// provider's deprecated flat attributes (e.g. imported ones) get their block
+// populated. Secrets are not returned by the proxy and are kept from state.
+// It returns the flat attributes it took over, which must not be read back.
+func setModelProviderBlocks(d *schema.ResourceData, params LiteLLMParams) map[string]bool {
+	encoded, _ := json.Marshal(params)
+	returned := make(map[string]interface{})
+	json.Unmarshal(encoded, &returned)
+
+	family := ModelProviderFamily(params.CustomLLMProvider)
+	if params.CustomLLMProvider == "" {
+		family = ModelProviderFamily(d.Get("custom_llm_provider").(string))
+	}
+
+	handled := make(map[string]bool)
+	for _, block := range modelProviderBlocks {
+		inState := len(d.Get(block.name).([]interface{})) > 0
+		if !inState {
+			if !block.deprecatedFlat || block.name != family {
+				continue
+			}
+			// Keep models configured with the deprecated attributes on them
+			usesFlat := false
+			for _, field := range block.fields {
+				if d.Get(field.flat).(string) != "" {
+					usesFlat = true
+				}
+			}
+			if usesFlat {
+				continue
+			}
+		}
+
+		values := make(map[string]interface{}, len(block.fields))
+		for _, field := range block.fields {
+			current := d.Get(fmt.Sprintf("%s.0.%s", block.name, field.name)).(string)
+			if field.sensitive {
+				values[field.name] = current
+				values[field.name+"_wo_version"] = d.Get(fmt.Sprintf("%s.0.%s_wo_version", block.name, field.name))
+				continue
+			}
+			v, _ := returned[field.param].(string)
+			values[field.name] = GetStringValue(v, current)
+		}
+
+		if !inState {
+			empty := true
+			for _, v := range values {
+				if str, _ := v.(string); str != "" {
+					empty = false
+				}
+			}
+			if empty {
+				continue
+			}
+		}
+
+		d.Set(block.name, []interface{}{values})
+		for _, field := range block.fields {
+			handled[field.flat] = true
+		}
+	}
+	return handled
+}
+
+// resourceLiteLLMModelStateUpgradeV0 moves the deprecated aws_* and vertex_*
+// attributes into the aws and vertex blocks.
+func resourceLiteLLMModelStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	for _, block := range modelProviderBlocks {
+		if !block.deprecatedFlat {
+			continue
+		}
+		values := make(map[string]interface{}, len(block.fields))
+		moved := false
+		for _, field := range block.fields {
+			v, _ := rawState[field.flat].(string)
+			values[field.name] = v
+			if v != "" {
+				moved = true
+			}
+			delete(rawState, field.flat)
+		}
+		if moved {
+			log.Printf("[INFO] Moving litellm_model %v %s attributes into the %s block", rawState["id"], block.name, block.name)
+			rawState[block.name] = []interface{}{values}
+		}
+	}
+
+	return rawState, nil
+}
+
+// upgradedProviderBlock reports whether the block in state was filled in by
+// resourceLiteLLMModelStateUpgradeV0 while the configuration still sets the
+// same values through the deprecated flat attributes. d holds the prior state
+// and the configuration.
+func upgradedProviderBlock(d *schema.ResourceData, block modelProviderBlock) bool {
+	if providerBlockConfigured(d, block.name) {
+		return false
+	}
+	old, _ := d.GetChange(block.name)
+	items, _ := old.([]interface{})
+	if len(items) == 0 || items[0] == nil {
+		return false
+	}
+	values := items[0].(map[string]interface{})
+	for _, field := range block.fields {
+		if v, _ := values[field.name].(string); v != d.Get(field.flat).(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// providerBlockConfigured reports whether the configuration sets the block.
+// Reading it through d would fall back to the block in state.
+func providerBlockConfigured(d *schema.ResourceData, name string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	v := config.GetAttr(name)
+	return !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0)
+}
+
+// suppressUpgradedProviderBlockDiff keeps a block moved into state by the
+// upgrade while the configuration still uses the deprecated attributes.
+func suppressUpgradedProviderBlockDiff(k, old, new string, d *schema.ResourceData) bool {
+	name := strings.SplitN(k, ".", 2)[0]
+	for _, block := range modelProviderBlocks {
+		if block.name == name {
+			return upgradedProviderBlock(d, block)
+		}
+	}
+	return false
+}
+
+// suppressUpgradedProviderAttributeDiff is the counterpart of
+// suppressUpgradedProviderBlockDiff for the deprecated attributes, whose
+// values the upgrade moved out of state.
+func suppressUpgradedProviderAttributeDiff(k, old, new string, d *schema.ResourceData) bool {
+	for _, block := range modelProviderBlocks {
+		for _, field := range block.fields {
+			if block.deprecatedFlat && field.flat == k {
+				return old == "" && upgradedProviderBlock(d, block)
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package litellm
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectPermissionSchema returns the object_permission block shared by keys,
+// teams and organizations.
+func objectPermissionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "MCP servers and vector stores this principal may use",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mcp_servers": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of MCP server IDs that may be used",
+				},
+				"mcp_access_groups": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of MCP access groups whose servers may be used",
+				},
+				"vector_stores": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of vector store IDs that may be used",
+				},
+				"mcp_tool_permissions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Restricts an MCP server to a subset of its tools",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"server_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "ID of the MCP server",
+							},
+							"tools": {
+								Type:        schema.TypeList,
+								Required:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Names of the tools that may be called on the server",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandObjectPermission converts the object_permission block into the API
+// payload. It returns nil when the block is not configured, or an empty
+// permission when the block was removed so the proxy revokes the old grant.
+func expandObjectPermission(d *schema.ResourceData) *ObjectPermission {
+	permission := &ObjectPermission{
+		MCPServers:         []string{},
+		MCPAccessGroups:    []string{},
+		VectorStores:       []string{},
+		MCPToolPermissions: map[string][]string{},
+	}
+
+	blocks := d.Get("object_permission").([]interface{})
+	if len(blocks) == 0 {
+		if d.HasChange("object_permission") {
+			return permission
+		}
+		return nil
+	}
+	if blocks[0] == nil {
+		return permission
+	}
+
+	block := blocks[0].(map[string]interface{})
+	permission.MCPServers = expandStringList(block["mcp_servers"].([]interface{}))
+	permission.MCPAccessGroups = expandStringList(block["mcp_access_groups"].([]interface{}))
+	permission.VectorStores = expandStringList(block["vector_stores"].([]interface{}))
+	for _, item := range block["mcp_tool_permissions"].(*schema.Set).List() {
+		toolPermission := item.(map[string]interface{})
+		serverID := toolPermission["server_id"].(string)
+		permission.MCPToolPermissions[serverID] = expandStringList(toolPermission["tools"].([]interface{}))
+	}
+
+	return permission
+}
+
+// flattenObjectPermission converts an API object permission into the
+// object_permission block. A permission that grants nothing flattens to an
+// empty list so an unconfigured block does not show a diff.
+func flattenObjectPermission(permission *ObjectPermission) []interface{} {
+	if permission == nil ||
+		(len(permission.MCPServers) == 0 && len(permission.MCPAccessGroups) == 0 &&
+			len(permission.VectorStores) == 0 && len(permission.MCPToolPermissions) == 0) {
+		return []interface{}{}
+	}
+
+	serverIDs := make([]string, 0, len(permission.MCPToolPermissions))
+	for serverID := range permission.MCPToolPermissions {
+		serverIDs = append(serverIDs, serverID)
+	}
+	sort.Strings(serverIDs)
+
+	toolPermissions := make([]interface{}, 0, len(serverIDs))
+	for _, serverID := range serverIDs {
+		toolPermissions = append(toolPermissions, map[string]interface{}{
+			"server_id": serverID,
+			"tools":     permission.MCPToolPermissions[serverID],
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mcp_servers":          permission.MCPServers,
+			"mcp_access_groups":    permission.MCPAccessGroups,
+			"vector_stores":        permission.VectorStores,
+			"mcp_tool_permissions": toolPermissions,
+		},
+	}
+}
+
+// decodeObjectPermission converts a loosely typed object_permission value from
+// an API response into an ObjectPermission.
+func decodeObjectPermission(value interface{}) *ObjectPermission {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var permission ObjectPermission
+	if err := json.Unmarshal(raw, &permission); err != nil {
+		return nil
+	}
+	return &permission
+}
@@ -1,6 +1,8 @@
 package litellm
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -9,6 +11,9 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
 			"litellm_model":                   resourceLiteLLMModel(),
+			"litellm_model_group":             resourceLiteLLMModelGroup(),
+			"litellm_router_settings":         resourceLiteLLMRouterSettings(),
+			"litellm_general_settings":        resourceLiteLLMGeneralSettings(),
 			"litellm_team":                    ResourceLiteLLMTeam(),
 			"litellm_organization":            resourceLiteLLMOrganization(),
 			"litellm_organization_member":     resourceLiteLLMOrganizationMember(),
@@ -19,22 +24,31 @@ func Provider() *schema.Provider {
 			"litellm_mcp_server":              resourceLiteLLMMCPServer(),
 			"litellm_credential":              resourceLiteLLMCredential(),
 			"litellm_vector_store":            resourceLiteLLMVectorStore(),
+			"litellm_guardrail":               resourceLiteLLMGuardrail(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"litellm_credential":   dataSourceLiteLLMCredential(),
-			"litellm_vector_store": dataSourceLiteLLMVectorStore(),
+			"litellm_credential":    dataSourceLiteLLMCredential(),
+			"litellm_credentials":   dataSourceLiteLLMCredentials(),
+			"litellm_keys":          dataSourceLiteLLMKeys(),
+			"litellm_model":         dataSourceLiteLLMModel(),
+			"litellm_models":        dataSourceLiteLLMModels(),
+			"litellm_organization":  dataSourceLiteLLMOrganization(),
+			"litellm_organizations": dataSourceLiteLLMOrganizations(),
+			"litellm_team":          dataSourceLiteLLMTeam(),
+			"litellm_teams":         dataSourceLiteLLMTeams(),
+			"litellm_vector_store":  dataSourceLiteLLMVectorStore(),
 		},
 		Schema: map[string]*schema.Schema{
 			"api_base": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   false,
 				DefaultFunc: schema.EnvDefaultFunc("LITELLM_API_BASE", nil),
 				Description: "The base URL of the LiteLLM API",
 			},
 			"api_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("LITELLM_API_KEY", nil),
 				Description: "The API key for authenticating with LiteLLM",
@@ -52,12 +66,20 @@ func Provider() *schema.Provider {
 }
 
 // providerConfigure configures the provider with the given schema data.
+// api_base and api_key are optional in the schema so that it matches the
+// plugin-framework provider it is muxed with, so they are checked here.
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := ProviderConfig{
 		APIBase:            d.Get("api_base").(string),
 		APIKey:             d.Get("api_key").(string),
 		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
 	}
+	if config.APIBase == "" {
+		return nil, fmt.Errorf("api_base must be set in the provider block or through LITELLM_API_BASE")
+	}
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("api_key must be set in the provider block or through LITELLM_API_KEY")
+	}
 
 	return NewClient(config.APIBase, config.APIKey, config.InsecureSkipVerify), nil
 }
@@ -0,0 +1,121 @@
+package litellm
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// ProviderServer returns a protocol v5 server that muxes the SDKv2 provider
+// with the plugin-framework provider, which serves ephemeral resources.
+func ProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		Provider().GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider is the plugin-framework half of the provider. Its schema
+// must stay identical to the SDKv2 provider schema for muxing to work.
+type frameworkProvider struct{}
+
+type frameworkProviderModel struct {
+	APIBase            types.String `tfsdk:"api_base"`
+	APIKey             types.String `tfsdk:"api_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// NewFrameworkProvider returns the plugin-framework provider.
+func NewFrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "litellm"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_base": schema.StringAttribute{
+				Optional:    true,
+				Description: "The base URL of the LiteLLM API",
+			},
+			"api_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The API key for authenticating with LiteLLM",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification. Only use for development or when using self-signed certificates",
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.APIBase.IsUnknown() || config.APIKey.IsUnknown() || config.InsecureSkipVerify.IsUnknown() {
+		// Terraform configures the provider again once the values are known
+		return
+	}
+
+	apiBase := config.APIBase.ValueString()
+	if apiBase == "" {
+		apiBase = os.Getenv("LITELLM_API_BASE")
+	}
+	apiKey := config.APIKey.ValueString()
+	if apiKey == "" {
+		apiKey = os.Getenv("LITELLM_API_KEY")
+	}
+	insecureSkipVerify := config.InsecureSkipVerify.ValueBool()
+	if config.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify, _ = strconv.ParseBool(os.Getenv("LITELLM_INSECURE_SKIP_VERIFY"))
+	}
+
+	if apiBase == "" {
+		resp.Diagnostics.AddError("Missing api_base", "api_base must be set in the provider block or through LITELLM_API_BASE")
+	}
+	if apiKey == "" {
+		resp.Diagnostics.AddError("Missing api_key", "api_key must be set in the provider block or through LITELLM_API_KEY")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewClient(apiBase, apiKey, insecureSkipVerify)
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralKey,
+	}
+}
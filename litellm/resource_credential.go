@@ -2,10 +2,11 @@ package litellm
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceLiteLLMCredential() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceLiteLLMCredentialCreate,
 		Read:   resourceLiteLLMCredentialRead,
 		Update: resourceLiteLLMCredentialUpdate,
@@ -13,6 +14,7 @@ func resourceLiteLLMCredential() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceLiteLLMCredentialCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"credential_name": {
@@ -33,12 +35,33 @@ func resourceLiteLLMCredential() *schema.Resource {
 				Description: "Additional information about the credential",
 			},
 			"credential_values": {
-				Type:        schema.TypeMap,
-				Required:    true,
-				Sensitive:   true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "Sensitive credential values (API keys, tokens, etc.)",
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Sensitive:     true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"credential_values_wo"},
+				AtLeastOneOf:  credentialValueSources(),
+				Description:   "Sensitive credential values (API keys, tokens, etc.)",
+			},
+			"credential_values_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsJSON,
+				AtLeastOneOf: credentialValueSources(),
+				Description:  "Write-only credential values as a JSON object, e.g. jsonencode({api_key = var.api_key}). Sent to the proxy but never stored in state",
+			},
+			"credential_values_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Change this value to resend credential_values_wo to the proxy",
 			},
 		},
 	}
+
+	for name, s := range credentialBlockSchemas() {
+		r.Schema[name] = s
+	}
+	return r
 }
@@ -1,12 +1,15 @@
 package litellm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -53,13 +56,59 @@ func retryCredentialRead(d *schema.ResourceData, m interface{}, maxRetries int)
 	return err
 }
 
+// expandCredentialValues returns the credential_values to send, merging the
+// typed provider block into the map. The proxy replaces the stored values as
+// a whole, so on update they are only sent when one of their sources or
+// credential_values_version changed, and the write-only secrets are sent
+// with them. Otherwise nothing is sent and the proxy keeps the stored values.
+func expandCredentialValues(d *schema.ResourceData, isUpdate bool) (map[string]interface{}, error) {
+	credValuesMap := make(map[string]interface{})
+	if isUpdate && !d.HasChanges(append(credentialValueSources(), "credential_values_version")...) {
+		return credValuesMap, nil
+	}
+
+	for k, v := range d.Get("credential_values").(map[string]interface{}) {
+		credValuesMap[k] = v
+	}
+	expandCredentialBlocks(d, credValuesMap)
+	addCredentialBlockWriteOnlySecrets(d, credValuesMap)
+	if raw := getWriteOnlyString(d, cty.GetAttrPath("credential_values_wo")); raw != "" {
+		var writeOnly map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &writeOnly); err != nil {
+			return nil, fmt.Errorf("credential_values_wo must be a JSON object: %w", err)
+		}
+		for k, v := range writeOnly {
+			credValuesMap[k] = v
+		}
+	}
+	return credValuesMap, nil
+}
+
+// checkCredentialExists fails with a clear diagnostic when a
+// litellm_credential_name does not match any credential on the proxy, which
+// otherwise only surfaces as a routing error on the first request.
+func checkCredentialExists(client *Client, credentialName string) error {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("/credentials/by_name/%s", url.PathEscape(credentialName)), nil)
+	if err != nil {
+		return fmt.Errorf("failed to look up credential %q: %w", credentialName, err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleCredentialAPIResponse(resp, nil, client); err != nil {
+		if err.Error() == "credential_not_found" {
+			return fmt.Errorf("litellm_credential_name %q does not match any credential on the proxy; create it with a litellm_credential resource or fix the name", credentialName)
+		}
+		return fmt.Errorf("failed to look up credential %q: %w", credentialName, err)
+	}
+	return nil
+}
+
 func resourceLiteLLMCredentialCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
 	credentialName := d.Get("credential_name").(string)
 	modelID := d.Get("model_id").(string)
 	credentialInfo := d.Get("credential_info").(map[string]interface{})
-	credentialValues := d.Get("credential_values").(map[string]interface{})
 
 	// Convert credential_info to map[string]interface{} for JSON
 	credInfoMap := make(map[string]interface{})
@@ -67,10 +116,9 @@ func resourceLiteLLMCredentialCreate(d *schema.ResourceData, m interface{}) erro
 		credInfoMap[k] = v
 	}
 
-	// Convert credential_values to map[string]interface{} for JSON
-	credValuesMap := make(map[string]interface{})
-	for k, v := range credentialValues {
-		credValuesMap[k] = v
+	credValuesMap, err := expandCredentialValues(d, false)
+	if err != nil {
+		return err
 	}
 
 	credentialRequest := CredentialRequest{
@@ -104,7 +152,7 @@ func resourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) error
 
 	// Try to get credential by name first
 	modelID := d.Get("model_id").(string)
-	endpoint := fmt.Sprintf("/credentials/by_name/%s", credentialName)
+	endpoint := fmt.Sprintf("/credentials/by_name/%s", url.PathEscape(credentialName))
 	if modelID != "" {
 		endpoint += fmt.Sprintf("?model_id=%s", modelID)
 	}
@@ -143,7 +191,6 @@ func resourceLiteLLMCredentialUpdate(d *schema.ResourceData, m interface{}) erro
 	credentialName := d.Id()
 
 	credentialInfo := d.Get("credential_info").(map[string]interface{})
-	credentialValues := d.Get("credential_values").(map[string]interface{})
 
 	// Convert credential_info to map[string]interface{} for JSON
 	credInfoMap := make(map[string]interface{})
@@ -151,10 +198,9 @@ func resourceLiteLLMCredentialUpdate(d *schema.ResourceData, m interface{}) erro
 		credInfoMap[k] = v
 	}
 
-	// Convert credential_values to map[string]interface{} for JSON
-	credValuesMap := make(map[string]interface{})
-	for k, v := range credentialValues {
-		credValuesMap[k] = v
+	credValuesMap, err := expandCredentialValues(d, true)
+	if err != nil {
+		return err
 	}
 
 	credentialRequest := CredentialRequest{
@@ -163,7 +209,7 @@ func resourceLiteLLMCredentialUpdate(d *schema.ResourceData, m interface{}) erro
 		CredentialValues: credValuesMap,
 	}
 
-	endpoint := fmt.Sprintf("/credentials/%s", credentialName)
+	endpoint := fmt.Sprintf("/credentials/%s", url.PathEscape(credentialName))
 	resp, err := MakeRequest(client, "PATCH", endpoint, credentialRequest)
 	if err != nil {
 		return fmt.Errorf("failed to update credential: %w", err)
@@ -183,7 +229,7 @@ func resourceLiteLLMCredentialDelete(d *schema.ResourceData, m interface{}) erro
 	client := m.(*Client)
 	credentialName := d.Id()
 
-	endpoint := fmt.Sprintf("/credentials/%s", credentialName)
+	endpoint := fmt.Sprintf("/credentials/%s", url.PathEscape(credentialName))
 	resp, err := MakeRequest(client, "DELETE", endpoint, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete credential: %w", err)
@@ -1,14 +1,19 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync/atomic"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // newTestResourceData creates a *schema.ResourceData with the credential schema,
@@ -199,3 +204,326 @@ func TestRetryCredentialRead_ConnectionError(t *testing.T) {
 	// Connection error should not be retried (not a "credential_not_found")
 	fmt.Printf("connection error (expected): %v\n", err)
 }
+
+func TestCredentialValuesWriteOnlySentOnCreateAndVersionChange(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"credential_name": "openai-prod", "credential_info": {"provider": "openai"}}`))
+		default:
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			sent = append(sent, body)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	providerConfig, _ := tfprotov5.NewDynamicValue(providerType, objectWithNulls(providerType, map[string]tftypes.Value{
+		"api_base": tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	}))
+	if resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig}); err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, resp.Diagnostics)
+	}
+
+	credentialType := schemaResp.ResourceSchemas["litellm_credential"].ValueType().(tftypes.Object)
+	config := func(purpose string, version int) tftypes.Value {
+		return objectWithNulls(credentialType, map[string]tftypes.Value{
+			"credential_name": tftypes.NewValue(tftypes.String, "openai-prod"),
+			"credential_info": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"purpose": tftypes.NewValue(tftypes.String, purpose),
+			}),
+			"credential_values_wo":      tftypes.NewValue(tftypes.String, `{"api_key": "sk-secret"}`),
+			"credential_values_version": tftypes.NewValue(tftypes.Number, version),
+		})
+	}
+
+	prior := tftypes.NewValue(credentialType, nil)
+	apply := func(cfg tftypes.Value) map[string]interface{} {
+		t.Helper()
+		priorState, _ := tfprotov5.NewDynamicValue(credentialType, prior)
+		configValue, _ := tfprotov5.NewDynamicValue(credentialType, cfg)
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "litellm_credential",
+			PriorState:       &priorState,
+			ProposedNewState: &configValue,
+			Config:           &configValue,
+		})
+		if err != nil || len(planResp.Diagnostics) > 0 {
+			t.Fatalf("PlanResourceChange failed: %v %v", err, planResp.Diagnostics)
+		}
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       "litellm_credential",
+			PriorState:     &priorState,
+			PlannedState:   planResp.PlannedState,
+			Config:         &configValue,
+			PlannedPrivate: planResp.PlannedPrivate,
+		})
+		if err != nil || len(applyResp.Diagnostics) > 0 {
+			t.Fatalf("ApplyResourceChange failed: %v %v", err, applyResp.Diagnostics)
+		}
+		prior, err = applyResp.NewState.Unmarshal(credentialType)
+		if err != nil {
+			t.Fatalf("failed to decode new state: %v", err)
+		}
+		return sent[len(sent)-1]
+	}
+
+	body := apply(config("chat", 1))
+	if values, _ := body["credential_values"].(map[string]interface{}); values["api_key"] != "sk-secret" {
+		t.Fatalf("credential_values_wo not sent on create: %v", body)
+	}
+	var attrs map[string]tftypes.Value
+	prior.As(&attrs)
+	if !attrs["credential_values_wo"].IsNull() {
+		t.Fatal("credential_values_wo stored in state")
+	}
+
+	if body := apply(config("embeddings", 1)); body["credential_values"] != nil {
+		t.Fatalf("credential values resent without a version change: %v", body)
+	}
+	body = apply(config("embeddings", 2))
+	if values, _ := body["credential_values"].(map[string]interface{}); values["api_key"] != "sk-secret" {
+		t.Fatalf("credential_values_wo not resent after the version change: %v", body)
+	}
+}
+
+func TestCredentialBlockWriteOnlySecretSentOnCreateAndVersionChange(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"credential_name": "openai-prod", "credential_info": {"provider": "openai"}}`))
+		default:
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			sent = append(sent, body)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	providerConfig, _ := tfprotov5.NewDynamicValue(providerType, objectWithNulls(providerType, map[string]tftypes.Value{
+		"api_base": tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	}))
+	if resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig}); err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, resp.Diagnostics)
+	}
+
+	credentialType := schemaResp.ResourceSchemas["litellm_credential"].ValueType().(tftypes.Object)
+	openaiType := credentialType.AttributeTypes["openai"].(tftypes.List).ElementType.(tftypes.Object)
+	config := func(purpose string, version int) tftypes.Value {
+		return objectWithNulls(credentialType, map[string]tftypes.Value{
+			"credential_name": tftypes.NewValue(tftypes.String, "openai-prod"),
+			"credential_info": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"purpose": tftypes.NewValue(tftypes.String, purpose),
+			}),
+			"openai": tftypes.NewValue(tftypes.List{ElementType: openaiType}, []tftypes.Value{
+				objectWithNulls(openaiType, map[string]tftypes.Value{
+					"api_key_wo":         tftypes.NewValue(tftypes.String, "sk-secret"),
+					"api_key_wo_version": tftypes.NewValue(tftypes.Number, version),
+				}),
+			}),
+		})
+	}
+
+	prior := tftypes.NewValue(credentialType, nil)
+	apply := func(cfg tftypes.Value) map[string]interface{} {
+		t.Helper()
+		priorState, _ := tfprotov5.NewDynamicValue(credentialType, prior)
+		configValue, _ := tfprotov5.NewDynamicValue(credentialType, cfg)
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "litellm_credential",
+			PriorState:       &priorState,
+			ProposedNewState: &configValue,
+			Config:           &configValue,
+		})
+		if err != nil || len(planResp.Diagnostics) > 0 {
+			t.Fatalf("PlanResourceChange failed: %v %v", err, planResp.Diagnostics)
+		}
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       "litellm_credential",
+			PriorState:     &priorState,
+			PlannedState:   planResp.PlannedState,
+			Config:         &configValue,
+			PlannedPrivate: planResp.PlannedPrivate,
+		})
+		if err != nil || len(applyResp.Diagnostics) > 0 {
+			t.Fatalf("ApplyResourceChange failed: %v %v", err, applyResp.Diagnostics)
+		}
+		prior, err = applyResp.NewState.Unmarshal(credentialType)
+		if err != nil {
+			t.Fatalf("failed to decode new state: %v", err)
+		}
+		return sent[len(sent)-1]
+	}
+
+	body := apply(config("chat", 1))
+	if values, _ := body["credential_values"].(map[string]interface{}); values["api_key"] != "sk-secret" {
+		t.Fatalf("openai.api_key_wo not sent on create: %v", body)
+	}
+	var attrs map[string]tftypes.Value
+	prior.As(&attrs)
+	var blocks []tftypes.Value
+	attrs["openai"].As(&blocks)
+	var openai map[string]tftypes.Value
+	blocks[0].As(&openai)
+	if !openai["api_key_wo"].IsNull() {
+		t.Fatal("openai.api_key_wo stored in state")
+	}
+
+	if body := apply(config("embeddings", 1)); body["credential_values"] != nil {
+		t.Fatalf("credential values resent without a version change: %v", body)
+	}
+	body = apply(config("embeddings", 2))
+	if values, _ := body["credential_values"].(map[string]interface{}); values["api_key"] != "sk-secret" {
+		t.Fatalf("openai.api_key_wo not resent after the version change: %v", body)
+	}
+}
+
+func TestCredentialBlocksValidatedAtPlan(t *testing.T) {
+	res := resourceLiteLLMCredential()
+	for name, tc := range map[string]struct {
+		config  map[string]interface{}
+		wantErr string
+	}{
+		"typo in block": {
+			config:  map[string]interface{}{"openai": []interface{}{map[string]interface{}{"api_bsae": "https://api.openai.com/v1"}}},
+			wantErr: "unknown key",
+		},
+		"azure without api_base": {
+			config:  map[string]interface{}{"azure": []interface{}{map[string]interface{}{"api_version": "2024-10-21"}}},
+			wantErr: "api_base",
+		},
+		"vertex credentials not JSON": {
+			config: map[string]interface{}{"vertex": []interface{}{map[string]interface{}{
+				"project": "acme", "location": "us-central1", "credentials": "/etc/sa.json",
+			}}},
+			wantErr: "credentials",
+		},
+		"two provider blocks": {
+			config: map[string]interface{}{
+				"openai":    []interface{}{map[string]interface{}{"api_key": "sk-1"}},
+				"anthropic": []interface{}{map[string]interface{}{"api_key": "sk-2"}},
+			},
+			wantErr: "conflicts with",
+		},
+		"no values": {
+			config:  map[string]interface{}{},
+			wantErr: "one of",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.config["credential_name"] = "c"
+			diags := res.Validate(terraform.NewResourceConfigRaw(tc.config))
+			var errs []string
+			for _, diag := range diags {
+				errs = append(errs, diag.Summary+" "+diag.Detail)
+			}
+			if !strings.Contains(strings.Join(errs, "\n"), tc.wantErr) {
+				t.Fatalf("expected an error mentioning %q, got %v", tc.wantErr, errs)
+			}
+		})
+	}
+
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"credential_name":   "c",
+		"credential_values": map[string]interface{}{"api_key": "sk-1"},
+		"anthropic":         []interface{}{map[string]interface{}{"api_key": "sk-2"}},
+	}), nil)
+	if err == nil || !strings.Contains(err.Error(), "[api_key]") {
+		t.Fatalf("expected a duplicate api_key error, got %v", err)
+	}
+}
+
+func TestCredentialBlockMergedIntoValues(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMCredential().Schema, map[string]interface{}{
+		"credential_name":   "azure-prod",
+		"credential_values": map[string]interface{}{"azure_ad_token": "token"},
+		"azure": []interface{}{map[string]interface{}{
+			"api_base":      "https://acme.openai.azure.com",
+			"api_version":   "2024-10-21",
+			"tenant_id":     "7f7c6f0e-2d4e-4c1a-9a57-0c8b8f5a8b11",
+			"client_id":     "0b9f1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d",
+			"client_secret": "secret",
+		}},
+	})
+
+	values, err := expandCredentialValues(d, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"azure_ad_token": "token",
+		"api_base":       "https://acme.openai.azure.com",
+		"api_version":    "2024-10-21",
+		"tenant_id":      "7f7c6f0e-2d4e-4c1a-9a57-0c8b8f5a8b11",
+		"client_id":      "0b9f1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d",
+		"client_secret":  "secret",
+	}
+	if fmt.Sprint(values) != fmt.Sprint(want) {
+		t.Fatalf("unexpected credential_values:\n got: %v\nwant: %v", values, want)
+	}
+}
+
+func TestCredentialNamesEscapedInPaths(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		paths = append(paths, r.Method+" "+r.URL.EscapedPath())
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"credential_name": "team-a/openai", "credential_info": {}}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMCredential().Schema, map[string]interface{}{
+		"credential_name":   "team-a/openai",
+		"credential_values": map[string]interface{}{"api_key": "sk-secret"},
+	})
+	d.SetId("team-a/openai")
+	if err := resourceLiteLLMCredentialUpdate(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if err := resourceLiteLLMCredentialDelete(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	ds := schema.TestResourceDataRaw(t, dataSourceLiteLLMCredential().Schema, map[string]interface{}{
+		"credential_name": "team-a/openai",
+	})
+	if err := dataSourceLiteLLMCredentialRead(ds, client); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range paths {
+		if !strings.Contains(path, "team-a%2Fopenai") {
+			t.Errorf("credential name not escaped in %s", path)
+		}
+	}
+	if len(paths) != 4 {
+		t.Fatalf("expected update, read, delete and data source calls, got %v", paths)
+	}
+}
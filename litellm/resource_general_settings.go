@@ -0,0 +1,74 @@
+package litellm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// generalSettingsFields are the general_settings keys managed by
+// litellm_general_settings. Each attribute is named after its key.
+var generalSettingsFields = []string{
+	"max_parallel_requests",
+	"global_max_parallel_requests",
+	"allowed_ips",
+	"max_request_size_mb",
+	"alerting",
+	"alerting_threshold",
+}
+
+func resourceLiteLLMGeneralSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiteLLMGeneralSettingsCreate,
+		Read:   resourceLiteLLMGeneralSettingsRead,
+		Update: resourceLiteLLMGeneralSettingsUpdate,
+		Delete: resourceLiteLLMGeneralSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMGeneralSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_parallel_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum parallel requests per deployment",
+			},
+			"global_max_parallel_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum parallel requests across the whole proxy",
+			},
+			"allowed_ips": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				Description: "IP addresses allowed to call the proxy. All addresses are allowed when unset",
+			},
+			"max_request_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum request body size in megabytes",
+			},
+			"alerting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Alerting integrations to send alerts to, e.g. slack or email",
+			},
+			"alerting_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Seconds a request may hang before a slow-response alert is sent",
+			},
+		},
+	}
+}
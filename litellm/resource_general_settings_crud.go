@@ -0,0 +1,178 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointConfigFieldInfo   = "/config/field/info"
+	endpointConfigFieldUpdate = "/config/field/update"
+	endpointConfigFieldDelete = "/config/field/delete"
+)
+
+// generalSettingsID is the ID of the litellm_general_settings singleton.
+const generalSettingsID = "general_settings"
+
+func resourceLiteLLMGeneralSettingsCreate(d *schema.ResourceData, m interface{}) error {
+	if err := updateGeneralSettings(d, m.(*Client), false); err != nil {
+		return err
+	}
+
+	d.SetId(generalSettingsID)
+	return resourceLiteLLMGeneralSettingsRead(d, m)
+}
+
+func resourceLiteLLMGeneralSettingsRead(d *schema.ResourceData, m interface{}) error {
+	return readGeneralSettings(d, m.(*Client), false)
+}
+
+func resourceLiteLLMGeneralSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := updateGeneralSettings(d, m.(*Client), true); err != nil {
+		return err
+	}
+
+	return resourceLiteLLMGeneralSettingsRead(d, m)
+}
+
+// resourceLiteLLMGeneralSettingsDelete removes the managed keys from the
+// proxy's general_settings, so the proxy falls back to its defaults.
+func resourceLiteLLMGeneralSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	for _, field := range generalSettingsFields {
+		if _, ok := d.GetOk(field); !ok {
+			continue
+		}
+		if err := deleteGeneralSetting(client, field); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceLiteLLMGeneralSettingsImport adopts every managed key stored in the
+// proxy's general_settings. The import ID is ignored.
+func resourceLiteLLMGeneralSettingsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(generalSettingsID)
+	if err := readGeneralSettings(d, m.(*Client), true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateGeneralSettings writes each configured key through
+// /config/field/update and deletes keys removed from configuration. Keys never
+// set in configuration are left untouched.
+func updateGeneralSettings(d *schema.ResourceData, client *Client, isUpdate bool) error {
+	for _, field := range generalSettingsFields {
+		if isUpdate && !d.HasChange(field) {
+			continue
+		}
+
+		value, ok := d.GetOk(field)
+		if !ok {
+			if isUpdate {
+				if err := deleteGeneralSetting(client, field); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		updateReq := ConfigFieldUpdateRequest{
+			FieldName:  field,
+			FieldValue: value,
+			ConfigType: "general_settings",
+		}
+		log.Printf("[INFO] Updating general setting %s", field)
+
+		resp, err := MakeRequest(client, "POST", endpointConfigFieldUpdate, updateReq)
+		if err != nil {
+			return fmt.Errorf("error updating general setting %s: %w", field, err)
+		}
+		err = handleResponse(resp, fmt.Sprintf("updating general setting %s", field))
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteGeneralSetting(client *Client, field string) error {
+	deleteReq := ConfigFieldDeleteRequest{
+		FieldName:  field,
+		ConfigType: "general_settings",
+	}
+	log.Printf("[INFO] Deleting general setting %s", field)
+
+	resp, err := MakeRequest(client, "POST", endpointConfigFieldDelete, deleteReq)
+	if err != nil {
+		return fmt.Errorf("error deleting general setting %s: %w", field, err)
+	}
+	defer resp.Body.Close()
+
+	return handleResponse(resp, fmt.Sprintf("deleting general setting %s", field))
+}
+
+// getGeneralSetting returns the value of a general_settings key stored in the
+// proxy database, or nil when the key is not set.
+func getGeneralSetting(client *Client, field string) (interface{}, error) {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?field_name=%s", endpointConfigFieldInfo, url.QueryEscape(field)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading general setting %s: %w", field, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading general setting %s: %w", field, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		// The proxy answers 400 for keys missing from its database ("Field
+		// name=... not in DB", or no general_settings at all). Only known
+		// fields are looked up, so any 400 means the key is unset.
+		if resp.StatusCode == http.StatusBadRequest {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading general setting %s: %s - %s", field, resp.Status, string(body))
+	}
+
+	var info ConfigFieldInfoResponse
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("error decoding general setting %s: %w", field, err)
+	}
+	return info.FieldValue, nil
+}
+
+// readGeneralSettings refreshes the managed keys from the proxy. Only keys
+// with a value in state are read, unless all is set (on import), so keys
+// managed elsewhere never show up as drift.
+func readGeneralSettings(d *schema.ResourceData, client *Client, all bool) error {
+	for _, field := range generalSettingsFields {
+		if _, ok := d.GetOk(field); !ok && !all {
+			continue
+		}
+
+		value, err := getGeneralSetting(client, field)
+		if err != nil {
+			return err
+		}
+		if number, ok := value.(float64); ok {
+			value = int(number)
+		}
+		if err := d.Set(field, value); err != nil {
+			return fmt.Errorf("error setting %s: %w", field, err)
+		}
+	}
+	return nil
+}
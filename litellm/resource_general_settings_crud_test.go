@@ -0,0 +1,115 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newGeneralSettingsServer stores general_settings keys like the proxy's
+// /config/field endpoints and records the keys each call touched.
+func newGeneralSettingsServer(t *testing.T, stored map[string]interface{}, calls *[]string) *Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/config/field/info":
+			field := r.URL.Query().Get("field_name")
+			value, ok := stored[field]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"detail": {"error": "Field name=` + field + ` not in DB"}}`))
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"field_name": field, "field_value": value, "stored_in_db": true})
+		case "/config/field/update":
+			var req ConfigFieldUpdateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			*calls = append(*calls, "update "+req.FieldName)
+			stored[req.FieldName] = req.FieldValue
+			w.Write([]byte(`{}`))
+		case "/config/field/delete":
+			var req ConfigFieldDeleteRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			*calls = append(*calls, "delete "+req.FieldName)
+			delete(stored, req.FieldName)
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, "test-key", true)
+}
+
+func TestGeneralSettingsManagesDeclaredKeysOnly(t *testing.T) {
+	stored := map[string]interface{}{"alerting": []interface{}{"slack"}, "master_key": "sk-master"}
+	var calls []string
+	client := newGeneralSettingsServer(t, stored, &calls)
+
+	res := resourceLiteLLMGeneralSettings()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"max_parallel_requests": 100,
+		"allowed_ips":           []interface{}{"10.0.0.1", "10.0.0.2"},
+	})
+	if err := resourceLiteLLMGeneralSettingsCreate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(calls, []string{"update max_parallel_requests", "update allowed_ips"}) {
+		t.Fatalf("expected only the declared keys to be written, got %v", calls)
+	}
+	if stored["master_key"] != "sk-master" || !reflect.DeepEqual(stored["alerting"], []interface{}{"slack"}) {
+		t.Fatalf("undeclared keys were changed: %v", stored)
+	}
+	if d.Id() != "general_settings" || d.Get("max_parallel_requests").(int) != 100 || len(d.Get("alerting").([]interface{})) != 0 {
+		t.Fatalf("unexpected state: %v", d.State())
+	}
+
+	// Dropping allowed_ips from configuration deletes the key
+	calls = nil
+	state := d.State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"max_parallel_requests": 100}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, diags := res.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if _, ok := stored["allowed_ips"]; ok || !reflect.DeepEqual(calls, []string{"delete allowed_ips"}) {
+		t.Fatalf("expected only allowed_ips to be deleted, got calls %v", calls)
+	}
+
+	// A key deleted outside Terraform is cleared from state
+	delete(stored, "max_parallel_requests")
+	if err := resourceLiteLLMGeneralSettingsRead(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("max_parallel_requests").(int) != 0 {
+		t.Fatalf("expected max_parallel_requests to be cleared, got %v", d.Get("max_parallel_requests"))
+	}
+	stored["max_parallel_requests"] = 100
+	d = res.Data(state)
+
+	imported := res.Data(nil)
+	if _, err := resourceLiteLLMGeneralSettingsImport(context.Background(), imported, client); err != nil {
+		t.Fatal(err)
+	}
+	if imported.Id() != "general_settings" || imported.Get("max_parallel_requests").(int) != 100 || imported.Get("alerting.0").(string) != "slack" {
+		t.Fatalf("unexpected imported state: %v", imported.State())
+	}
+
+	calls = nil
+	if err := resourceLiteLLMGeneralSettingsDelete(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(calls, []string{"delete max_parallel_requests"}) || stored["master_key"] != "sk-master" {
+		t.Fatalf("expected delete to remove only managed keys, got calls %v, stored %v", calls, stored)
+	}
+}
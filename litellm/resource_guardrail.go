@@ -0,0 +1,102 @@
+package litellm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLiteLLMGuardrail() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiteLLMGuardrailCreate,
+		Read:   resourceLiteLLMGuardrailRead,
+		Update: resourceLiteLLMGuardrailUpdate,
+		Delete: resourceLiteLLMGuardrailDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"guardrail_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the guardrail, referenced from the guardrails list of keys and teams",
+			},
+			"litellm_params": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Guardrail integration settings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guardrail": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Guardrail integration type (e.g. aporia, bedrock, lakera, presidio)",
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"pre_call",
+								"post_call",
+								"during_call",
+							}, false),
+							Description: "When the guardrail runs (pre_call, post_call, during_call)",
+						},
+						"default_on": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Run the guardrail on every request without it being requested by the client",
+						},
+						"api_base": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base URL of the guardrail provider API",
+						},
+						"api_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+							Description: "API key for the guardrail provider. Write-only: sent to the proxy but never stored in state",
+						},
+						"api_key_version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Change this value to resend api_key to the proxy",
+						},
+						"additional_params": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional provider-specific guardrail parameters",
+						},
+					},
+				},
+			},
+			"guardrail_info": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional information about the guardrail",
+			},
+			// Read-only computed fields
+			"guardrail_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the guardrail",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the guardrail was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the guardrail was last updated",
+			},
+		},
+	}
+}
@@ -0,0 +1,179 @@
+package litellm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointGuardrailCreate = "/guardrails"
+	endpointGuardrailInfo   = "/guardrails/%s/info"
+	endpointGuardrailUpdate = "/guardrails/%s"
+	endpointGuardrailDelete = "/guardrails/%s"
+)
+
+// buildGuardrailRequest converts schema data to a GuardrailRequest
+func buildGuardrailRequest(d *schema.ResourceData) *GuardrailRequest {
+	litellmParams := make(map[string]interface{})
+
+	if paramsList := d.Get("litellm_params").([]interface{}); len(paramsList) > 0 && paramsList[0] != nil {
+		params := paramsList[0].(map[string]interface{})
+
+		// Provider-specific parameters go first so the typed attributes win on conflict
+		if additionalParams, ok := params["additional_params"].(map[string]interface{}); ok {
+			for key, value := range additionalParams {
+				if strValue, ok := value.(string); ok {
+					litellmParams[key] = parseAdditionalParamValue(strValue)
+				} else {
+					litellmParams[key] = value
+				}
+			}
+		}
+
+		litellmParams["guardrail"] = params["guardrail"].(string)
+		litellmParams["mode"] = params["mode"].(string)
+		litellmParams["default_on"] = params["default_on"].(bool)
+		if apiBase, ok := params["api_base"].(string); ok && apiBase != "" {
+			litellmParams["api_base"] = apiBase
+		}
+	}
+
+	apiKeyPath := cty.GetAttrPath("litellm_params").IndexInt(0).GetAttr("api_key")
+	if apiKey := getWriteOnlyString(d, apiKeyPath); apiKey != "" {
+		litellmParams["api_key"] = apiKey
+	}
+
+	req := &GuardrailRequest{
+		Guardrail: Guardrail{
+			GuardrailName: d.Get("guardrail_name").(string),
+			LiteLLMParams: litellmParams,
+		},
+	}
+
+	if guardrailInfo, ok := d.GetOk("guardrail_info"); ok {
+		req.Guardrail.GuardrailInfo = guardrailInfo.(map[string]interface{})
+	}
+
+	return req
+}
+
+// setGuardrailResourceData updates schema data from a GuardrailResponse.
+// guardrail_info holds strings, so other values the proxy returns are JSON
+// encoded.
+func setGuardrailResourceData(d *schema.ResourceData, resp *GuardrailResponse) error {
+	d.Set("guardrail_id", resp.GuardrailID)
+	d.Set("guardrail_name", resp.GuardrailName)
+	if err := d.Set("guardrail_info", stringifyMetadata(resp.GuardrailInfo)); err != nil {
+		return fmt.Errorf("error setting guardrail_info: %w", err)
+	}
+	d.Set("created_at", resp.CreatedAt)
+	d.Set("updated_at", resp.UpdatedAt)
+
+	params := map[string]interface{}{
+		"guardrail":  resp.LiteLLMParams.Guardrail,
+		"default_on": resp.LiteLLMParams.DefaultOn,
+		"api_base":   resp.LiteLLMParams.APIBase,
+		// api_key is write-only and additional_params may carry secrets, so
+		// neither is read back from the proxy; the configured values are kept.
+		"api_key_version":   d.Get("litellm_params.0.api_key_version"),
+		"additional_params": d.Get("litellm_params.0.additional_params"),
+	}
+	if mode, ok := resp.LiteLLMParams.Mode.(string); ok {
+		params["mode"] = mode
+	} else {
+		params["mode"] = d.Get("litellm_params.0.mode")
+	}
+
+	d.Set("litellm_params", []interface{}{params})
+	return nil
+}
+
+func resourceLiteLLMGuardrailCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	req := buildGuardrailRequest(d)
+
+	resp, err := MakeRequest(client, "POST", endpointGuardrailCreate, req)
+	if err != nil {
+		return fmt.Errorf("failed to create guardrail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var guardrailResp GuardrailResponse
+	if err := handleGuardrailAPIResponse(resp, &guardrailResp, client); err != nil {
+		return fmt.Errorf("failed to create guardrail: %w", err)
+	}
+
+	if guardrailResp.GuardrailID == "" {
+		return fmt.Errorf("failed to create guardrail: response did not include a guardrail_id")
+	}
+
+	d.SetId(guardrailResp.GuardrailID)
+	log.Printf("[INFO] Guardrail created with ID %s", guardrailResp.GuardrailID)
+
+	return resourceLiteLLMGuardrailRead(d, m)
+}
+
+func resourceLiteLLMGuardrailRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf(endpointGuardrailInfo, d.Id()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to read guardrail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var guardrailResp GuardrailResponse
+	if err := handleGuardrailAPIResponse(resp, &guardrailResp, client); err != nil {
+		if err.Error() == "guardrail_not_found" {
+			log.Printf("[WARN] Guardrail with ID %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read guardrail: %w", err)
+	}
+
+	return setGuardrailResourceData(d, &guardrailResp)
+}
+
+func resourceLiteLLMGuardrailUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	req := buildGuardrailRequest(d)
+
+	resp, err := MakeRequest(client, "PUT", fmt.Sprintf(endpointGuardrailUpdate, d.Id()), req)
+	if err != nil {
+		return fmt.Errorf("failed to update guardrail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleGuardrailAPIResponse(resp, nil, client); err != nil {
+		return fmt.Errorf("failed to update guardrail: %w", err)
+	}
+
+	log.Printf("[INFO] Guardrail updated with ID %s", d.Id())
+	return resourceLiteLLMGuardrailRead(d, m)
+}
+
+func resourceLiteLLMGuardrailDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	resp, err := MakeRequest(client, "DELETE", fmt.Sprintf(endpointGuardrailDelete, d.Id()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete guardrail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleGuardrailAPIResponse(resp, nil, client); err != nil {
+		if err.Error() != "guardrail_not_found" {
+			return fmt.Errorf("failed to delete guardrail: %w", err)
+		}
+	}
+
+	log.Printf("[INFO] Guardrail deleted with ID %s", d.Id())
+	d.SetId("")
+	return nil
+}
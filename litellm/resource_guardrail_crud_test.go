@@ -0,0 +1,106 @@
+package litellm
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGuardrailCreateSendsTypedParamsAndReadsBack(t *testing.T) {
+	var captured GuardrailRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/guardrails":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &captured)
+			w.Write([]byte(`{"guardrail_id":"g-1","guardrail_name":"pii"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/guardrails/g-1/info":
+			w.Write([]byte(`{
+				"guardrail_id": "g-1",
+				"guardrail_name": "pii",
+				"litellm_params": {"guardrail": "presidio", "mode": "pre_call", "default_on": true, "api_key": "sk-from-server", "presidio_language": "en"},
+				"guardrail_info": {"owner": "security", "priority": 2, "tags": ["pii"]},
+				"created_at": "2026-01-01T00:00:00Z"
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMGuardrail().Schema, map[string]interface{}{
+		"guardrail_name": "pii",
+		"litellm_params": []interface{}{
+			map[string]interface{}{
+				"guardrail":  "presidio",
+				"mode":       "pre_call",
+				"default_on": true,
+				"additional_params": map[string]interface{}{
+					"presidio_language":     "en",
+					"presidio_score_thresh": "0.5",
+				},
+			},
+		},
+		"guardrail_info": map[string]interface{}{"owner": "security"},
+	})
+
+	if err := resourceLiteLLMGuardrailCreate(d, client); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	params := captured.Guardrail.LiteLLMParams
+	if params["guardrail"] != "presidio" || params["mode"] != "pre_call" || params["default_on"] != true {
+		t.Fatalf("typed litellm_params not sent: %v", params)
+	}
+	if params["presidio_score_thresh"] != 0.5 {
+		t.Fatalf("additional_params not converted to JSON types: %v", params)
+	}
+	if _, sent := params["api_key"]; sent {
+		t.Fatalf("api_key sent without being configured: %v", params)
+	}
+
+	if d.Id() != "g-1" || d.Get("guardrail_id").(string) != "g-1" {
+		t.Fatalf("expected ID g-1, got %q", d.Id())
+	}
+	if d.Get("litellm_params.0.mode").(string) != "pre_call" {
+		t.Fatalf("mode not read back: %v", d.Get("litellm_params"))
+	}
+	if d.Get("litellm_params.0.api_key").(string) != "" {
+		t.Fatalf("server-returned api_key persisted into state")
+	}
+	additional := d.Get("litellm_params.0.additional_params").(map[string]interface{})
+	if len(additional) != 2 {
+		t.Fatalf("configured additional_params not preserved: %v", additional)
+	}
+	info := d.Get("guardrail_info").(map[string]interface{})
+	if info["owner"] != "security" || info["priority"] != "2" || info["tags"] != `["pii"]` {
+		t.Fatalf("non-string guardrail_info values not read back as strings: %v", info)
+	}
+}
+
+func TestGuardrailReadRemovesMissingGuardrail(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"detail":"Guardrail with ID g-1 not found"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMGuardrail().Schema, map[string]interface{}{
+		"guardrail_name": "pii",
+	})
+	d.SetId("g-1")
+
+	if err := resourceLiteLLMGuardrailRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected guardrail to be removed from state, got ID %q", d.Id())
+	}
+}
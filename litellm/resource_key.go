@@ -3,13 +3,18 @@ package litellm
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKey() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceKeyCreate,
 		ReadContext:   resourceKeyRead,
 		UpdateContext: resourceKeyUpdate,
@@ -17,6 +22,8 @@ func resourceKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceKeyCustomizeDiff,
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"key": {
 				Type:      schema.TypeString,
@@ -127,6 +134,13 @@ func resourceKey() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"deletion_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "block"}, false),
+				Description:  "What terraform destroy does with the key: delete it, or block it so its spend history is kept",
+			},
 			"tags": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -136,14 +150,126 @@ func resourceKey() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Computed: true,
 			},
+			"object_permission": objectPermissionSchema(),
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that regenerate the key when changed, keeping its token references, budgets and spend",
+			},
+			"rotate_after": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := parseDuration(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s: %w", k, err)}
+					}
+					return nil, nil
+				},
+				Description: "Regenerate the key on the next apply once this long has passed since the last rotation, e.g. \"30d\" or \"720h\"",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last rotation, or of creation if the key was never rotated (RFC 3339)",
+			},
 		},
 	}
+
+	// Version 0 is the schema before 0.2.0, when the raw key was the ID and
+	// was stored in state. Its attributes are otherwise a subset of today's.
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceKeyStateUpgradeV0,
+		},
+	}
+	return r
+}
+
+// resourceKeyStateUpgradeV0 moves a key whose ID is the raw sk- key to its
+// SHA-256 token_id, and drops the raw key from state.
+func resourceKeyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	if id, _ := rawState["id"].(string); strings.HasPrefix(id, "sk-") {
+		tokenID := hashKey(id)
+		log.Printf("[INFO] Migrating litellm_key ID from the raw key to token ID %s", tokenID)
+		rawState["id"] = tokenID
+		rawState["token_id"] = tokenID
+	}
+	delete(rawState, "key")
+
+	return rawState, nil
+}
+
+// keyRotationNeedsKey is the error returned when a rotation is due but no new
+// key value is configured.
+const keyRotationNeedsKey = "rotating a key requires its new value in the write-only key attribute, e.g. from a random_password whose keepers follow rotation_trigger"
+
+// keyRotationNeedsNewKey is the error returned when a rotation is due but the
+// write-only key still holds the current value.
+const keyRotationNeedsNewKey = "a rotation is due but the write-only key still holds the current key, change its value, e.g. through the keepers of the random_password it comes from"
+
+// resourceKeyCustomizeDiff plans a rotation when rotation_trigger changes or
+// rotate_after has elapsed, so the new token_id shows up in the plan.
+func resourceKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.HasChange("rotation_trigger") && !keyRotationDue(d.Get("rotated_at").(string), d.Get("rotate_after").(string), time.Now()) {
+		return nil
+	}
+	if newKey, diags := d.GetRawConfigAt(cty.GetAttrPath("key")); !diags.HasError() {
+		if newKey.IsNull() {
+			return fmt.Errorf(keyRotationNeedsKey)
+		}
+		if newKey.IsKnown() && hashKey(newKey.AsString()) == d.Id() {
+			return fmt.Errorf(keyRotationNeedsNewKey)
+		}
+	}
+	if err := d.SetNewComputed("rotated_at"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("token_id")
+}
+
+// keyRotationPlanned reports whether the plan rotates the key, which
+// resourceKeyCustomizeDiff marks by leaving token_id unknown. Without a plan
+// (e.g. in legacy tests) only a changed rotation_trigger rotates the key.
+func keyRotationPlanned(d *schema.ResourceData) bool {
+	plan := d.GetRawPlan()
+	if plan.IsNull() || !plan.IsKnown() {
+		return d.HasChange("rotation_trigger")
+	}
+	return !plan.GetAttr("token_id").IsKnown()
+}
+
+// keyRotationDue reports whether rotateAfter has elapsed since rotatedAt.
+func keyRotationDue(rotatedAt, rotateAfter string, now time.Time) bool {
+	if rotatedAt == "" || rotateAfter == "" {
+		return false
+	}
+	last, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return false
+	}
+	interval, err := parseDuration(rotateAfter)
+	if err != nil {
+		return false
+	}
+	return !now.Before(last.Add(interval))
 }
 
 func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 
-	key := &Key{}
+	// A configured key is used as the key's value; otherwise the proxy
+	// generates one
+	key := &Key{Key: getWriteOnlyString(d, cty.GetAttrPath("key"))}
 	mapResourceDataToKey(d, key)
 
 	createdKey, err := c.CreateKey(key)
@@ -155,6 +281,7 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	// Set the write-only key value so it's available during this apply
 	// but will not be persisted to state.
 	d.Set("key", createdKey.Key)
+	d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
 	return resourceKeyRead(ctx, d, m)
 }
 
@@ -172,12 +299,43 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	}
 
 	mapKeyToResourceData(d, key)
+
+	// deletion_behavior is not stored by the proxy; default it for imports
+	if d.Get("deletion_behavior").(string) == "" {
+		d.Set("deletion_behavior", "delete")
+	}
+
+	// Keys created before rotation support start their rotation clock at the
+	// key's creation time
+	if d.Get("rotated_at").(string) == "" && key.CreatedAt != "" {
+		if created, err := parseTimestamp(key.CreatedAt); err == nil {
+			d.Set("rotated_at", created.UTC().Format(time.RFC3339))
+		}
+	}
 	return nil
 }
 
 func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 
+	// Follow the plan rather than the clock: a saved plan that kept the key
+	// must not rotate it because rotate_after elapsed before the apply
+	if keyRotationPlanned(d) {
+		// The new value comes from the write-only key attribute, so the caller
+		// already knows it; a value generated by the proxy could not be stored
+		newKey := getWriteOnlyString(d, cty.GetAttrPath("key"))
+		if newKey == "" {
+			return diag.Errorf(keyRotationNeedsKey)
+		}
+		rotated, err := c.RegenerateKey(d.Id(), newKey)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error rotating key: %s", err))
+		}
+		log.Printf("[INFO] Rotated key %s, new token ID %s", d.Id(), rotated.TokenID)
+		d.SetId(rotated.TokenID)
+		d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
+	}
+
 	key := &Key{Key: d.Id()}
 	mapResourceDataToKey(d, key)
 
@@ -186,12 +344,32 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		return diag.FromErr(fmt.Errorf("error updating key: %s", err))
 	}
 
+	if d.HasChange("blocked") {
+		if d.Get("blocked").(bool) {
+			err = c.BlockKey(d.Id())
+		} else {
+			err = c.UnblockKey(d.Id())
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error changing key block state: %s", err))
+		}
+	}
+
 	return resourceKeyRead(ctx, d, m)
 }
 
 func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 
+	if d.Get("deletion_behavior").(string) == "block" {
+		if err := c.BlockKey(d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("error blocking key: %s", err))
+		}
+		log.Printf("[INFO] Blocked key %s instead of deleting it", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	err := c.DeleteKey(d.Id())
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting key: %s", err))
@@ -239,6 +417,7 @@ func mapResourceDataToKey(d *schema.ResourceData, key *Key) {
 	key.Guardrails = expandStringList(d.Get("guardrails").([]interface{}))
 	key.Blocked = d.Get("blocked").(bool)
 	key.Tags = expandStringList(d.Get("tags").([]interface{}))
+	key.ObjectPermission = expandObjectPermission(d)
 }
 
 func mapKeyToResourceData(d *schema.ResourceData, key *Key) {
@@ -316,4 +495,5 @@ func mapKeyToResourceData(d *schema.ResourceData, key *Key) {
 	if key.Spend != 0 {
 		d.Set("spend", key.Spend)
 	}
+	d.Set("object_permission", flattenObjectPermission(key.ObjectPermission))
 }
@@ -0,0 +1,541 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestKeyReadUnwrapsInfoEnvelope(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"key": "hashed-token",
+			"info": {
+				"key_alias": "ci",
+				"models": ["gpt-4o"],
+				"spend": 1.5,
+				"metadata": {"owner": "platform", "tags": ["ci"], "guardrails": ["pii-masking"]},
+				"object_permission": {
+					"object_permission_id": "op-1",
+					"mcp_servers": ["srv-1"],
+					"mcp_access_groups": [],
+					"vector_stores": ["vs-1"],
+					"mcp_tool_permissions": {"srv-1": ["search"]}
+				}
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceKey().Schema, map[string]interface{}{})
+	d.SetId("hashed-token")
+
+	if diags := resourceKeyRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	if got := d.Get("key_alias").(string); got != "ci" {
+		t.Fatalf("key_alias not read from info envelope: %q", got)
+	}
+	if got := d.Get("tags").([]interface{}); len(got) != 1 || got[0] != "ci" {
+		t.Fatalf("tags not read back from key metadata: %v", got)
+	}
+	if got := d.Get("metadata").(map[string]interface{}); len(got) != 1 || got["owner"] != "platform" {
+		t.Fatalf("reserved keys not stripped from metadata: %v", got)
+	}
+	if got := d.Get("object_permission.0.mcp_servers").([]interface{}); len(got) != 1 || got[0] != "srv-1" {
+		t.Fatalf("object_permission.mcp_servers not read back: %v", got)
+	}
+	if got := d.Get("object_permission.0.vector_stores").([]interface{}); len(got) != 1 || got[0] != "vs-1" {
+		t.Fatalf("object_permission.vector_stores not read back: %v", got)
+	}
+	toolPermissions := d.Get("object_permission.0.mcp_tool_permissions").(*schema.Set).List()
+	if len(toolPermissions) != 1 || toolPermissions[0].(map[string]interface{})["server_id"] != "srv-1" {
+		t.Fatalf("object_permission.mcp_tool_permissions not read back: %v", toolPermissions)
+	}
+}
+
+func TestKeyUpdateSendsObjectPermission(t *testing.T) {
+	var captured map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/key/update" {
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &captured)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceKey().Schema, map[string]interface{}{
+		"object_permission": []interface{}{
+			map[string]interface{}{
+				"mcp_access_groups": []interface{}{"dev"},
+				"mcp_tool_permissions": []interface{}{
+					map[string]interface{}{
+						"server_id": "srv-1",
+						"tools":     []interface{}{"search", "fetch"},
+					},
+				},
+			},
+		},
+	})
+	d.SetId("hashed-token")
+
+	if diags := resourceKeyUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+
+	permission, ok := captured["object_permission"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("update payload missing object_permission: %v", captured)
+	}
+	if groups := permission["mcp_access_groups"].([]interface{}); len(groups) != 1 || groups[0] != "dev" {
+		t.Fatalf("mcp_access_groups not sent: %v", permission)
+	}
+	tools := permission["mcp_tool_permissions"].(map[string]interface{})["srv-1"].([]interface{})
+	if len(tools) != 2 {
+		t.Fatalf("mcp_tool_permissions not sent: %v", permission)
+	}
+	if servers, ok := permission["mcp_servers"].([]interface{}); !ok || len(servers) != 0 {
+		t.Fatalf("unset mcp_servers should be sent as an empty list: %v", permission)
+	}
+}
+
+func TestKeyUpdateRevokesRemovedObjectPermission(t *testing.T) {
+	var captured map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/key/update" {
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &captured)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	res := resourceKey()
+	state := &terraform.InstanceState{ID: "hashed-token", Attributes: map[string]string{
+		"object_permission.#":                 "1",
+		"object_permission.0.vector_stores.#": "1",
+		"object_permission.0.vector_stores.0": "vs-1",
+	}}
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceKeyUpdate(context.Background(), d, NewClient(srv.URL, "test-key", true)); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+
+	permission, ok := captured["object_permission"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("removing the block should send an empty object_permission: %v", captured)
+	}
+	if stores, ok := permission["vector_stores"].([]interface{}); !ok || len(stores) != 0 {
+		t.Fatalf("vector_stores not revoked: %v", permission)
+	}
+}
+
+func TestKeyReadClearsObjectPermissionRemovedOnProxy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"key": "hashed-token", "info": {"key_alias": "ci", "object_permission": null}}`))
+	}))
+	defer srv.Close()
+
+	d := resourceKey().Data(&terraform.InstanceState{ID: "hashed-token", Attributes: map[string]string{
+		"object_permission.#":                 "1",
+		"object_permission.0.vector_stores.#": "1",
+		"object_permission.0.vector_stores.0": "vs-1",
+	}})
+	if diags := resourceKeyRead(context.Background(), d, NewClient(srv.URL, "test-key", true)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if permission := d.Get("object_permission").([]interface{}); len(permission) != 0 {
+		t.Fatalf("object_permission removed on the proxy kept in state: %v", permission)
+	}
+}
+
+func TestKeyRotationDue(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		rotatedAt, rotateAfter string
+		want                   bool
+	}{
+		{"2026-01-01T00:00:00Z", "30d", true},
+		{"2026-02-20T00:00:00Z", "30d", false},
+		{"2026-02-28T00:00:00Z", "24h", true},
+		{"", "30d", false},
+		{"2026-01-01T00:00:00Z", "", false},
+	}
+	for _, tc := range cases {
+		if got := keyRotationDue(tc.rotatedAt, tc.rotateAfter, now); got != tc.want {
+			t.Errorf("keyRotationDue(%q, %q) = %v, want %v", tc.rotatedAt, tc.rotateAfter, got, tc.want)
+		}
+	}
+}
+
+func TestKeyRotatesWhenRotateAfterElapses(t *testing.T) {
+	var regenerated map[string]interface{}
+	var updatedKey string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/key/regenerate":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &regenerated)
+			newKey, _ := regenerated["new_key"].(string)
+			json.NewEncoder(w).Encode(map[string]interface{}{"key": newKey, "token": hashKey(newKey), "max_budget": 50})
+		case "/key/update":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			updatedKey, _ = body["key"].(string)
+			w.Write([]byte(`{}`))
+		case "/key/info":
+			w.Write([]byte(`{"key": "` + r.URL.Query().Get("key") + `", "info": {"key_alias": "ci", "max_budget": 50, "spend": 12}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	providerConfig, _ := tfprotov5.NewDynamicValue(providerType, objectWithNulls(providerType, map[string]tftypes.Value{
+		"api_base": tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	}))
+	if resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig}); err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, resp.Diagnostics)
+	}
+
+	keyType := schemaResp.ResourceSchemas["litellm_key"].ValueType().(tftypes.Object)
+	priorState, _ := tfprotov5.NewDynamicValue(keyType, objectWithNulls(keyType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "old-hash"),
+		"token_id":          tftypes.NewValue(tftypes.String, "old-hash"),
+		"key_alias":         tftypes.NewValue(tftypes.String, "ci"),
+		"rotate_after":      tftypes.NewValue(tftypes.String, "30d"),
+		"rotated_at":        tftypes.NewValue(tftypes.String, "2020-01-01T00:00:00Z"),
+		"deletion_behavior": tftypes.NewValue(tftypes.String, "delete"),
+	}))
+	plan := func(key string) *tfprotov5.PlanResourceChangeResponse {
+		t.Helper()
+		set := map[string]tftypes.Value{
+			"key_alias":    tftypes.NewValue(tftypes.String, "ci"),
+			"rotate_after": tftypes.NewValue(tftypes.String, "30d"),
+		}
+		if key != "" {
+			set["key"] = tftypes.NewValue(tftypes.String, key)
+		}
+		config, _ := tfprotov5.NewDynamicValue(keyType, objectWithNulls(keyType, set))
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "litellm_key",
+			PriorState:       &priorState,
+			ProposedNewState: &config,
+			Config:           &config,
+		})
+		if err != nil {
+			t.Fatalf("PlanResourceChange failed: %v", err)
+		}
+		return planResp
+	}
+
+	if resp := plan(""); len(resp.Diagnostics) == 0 || !strings.Contains(resp.Diagnostics[0].Summary, "requires its new value") {
+		t.Fatalf("expected the plan to require a new key value, got %v", resp.Diagnostics)
+	}
+
+	planResp := plan("sk-rotated")
+	if len(planResp.Diagnostics) > 0 {
+		t.Fatalf("PlanResourceChange failed: %v", planResp.Diagnostics)
+	}
+	config, _ := tfprotov5.NewDynamicValue(keyType, objectWithNulls(keyType, map[string]tftypes.Value{
+		"key":          tftypes.NewValue(tftypes.String, "sk-rotated"),
+		"key_alias":    tftypes.NewValue(tftypes.String, "ci"),
+		"rotate_after": tftypes.NewValue(tftypes.String, "30d"),
+	}))
+	applyResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       "litellm_key",
+		PriorState:     &priorState,
+		PlannedState:   planResp.PlannedState,
+		Config:         &config,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil || len(applyResp.Diagnostics) > 0 {
+		t.Fatalf("ApplyResourceChange failed: %v %v", err, applyResp.Diagnostics)
+	}
+
+	if regenerated["key"] != "old-hash" || regenerated["new_key"] != "sk-rotated" {
+		t.Fatalf("expected /key/regenerate of old-hash to the configured key, got %v", regenerated)
+	}
+	newState, err := applyResp.NewState.Unmarshal(keyType)
+	if err != nil {
+		t.Fatalf("failed to decode new state: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	newState.As(&attrs)
+	var id, tokenID, spend, rotatedAt string
+	var spendValue big.Float
+	attrs["id"].As(&id)
+	attrs["token_id"].As(&tokenID)
+	attrs["rotated_at"].As(&rotatedAt)
+	attrs["spend"].As(&spendValue)
+	spend = spendValue.String()
+
+	// The configured value is the new secret: its hash is the new token
+	if id != hashKey("sk-rotated") || tokenID != id || updatedKey != id {
+		t.Fatalf("state not moved to the configured key's token: id %q, token_id %q, updated %q", id, tokenID, updatedKey)
+	}
+	if !attrs["key"].IsNull() {
+		t.Fatalf("rotated key stored in state: %v", attrs["key"])
+	}
+	if spend != "12" || rotatedAt == "2020-01-01T00:00:00Z" {
+		t.Fatalf("spend or rotated_at not refreshed: %s %s", spend, rotatedAt)
+	}
+}
+
+func TestKeyRotationFollowsThePlan(t *testing.T) {
+	var regenerated bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/key/regenerate":
+			regenerated = true
+			w.Write([]byte(`{"key": "sk-other", "token": "other-hash"}`))
+		case "/key/update":
+			w.Write([]byte(`{}`))
+		case "/key/info":
+			w.Write([]byte(`{"key": "` + r.URL.Query().Get("key") + `", "info": {"key_alias": "ci-2"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	providerConfig, _ := tfprotov5.NewDynamicValue(providerType, objectWithNulls(providerType, map[string]tftypes.Value{
+		"api_base": tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	}))
+	if resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig}); err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, resp.Diagnostics)
+	}
+
+	keyType := schemaResp.ResourceSchemas["litellm_key"].ValueType().(tftypes.Object)
+	current := hashKey("sk-current")
+	state := func(rotatedAt string) *tfprotov5.DynamicValue {
+		value, _ := tfprotov5.NewDynamicValue(keyType, objectWithNulls(keyType, map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, current),
+			"token_id":          tftypes.NewValue(tftypes.String, current),
+			"key_alias":         tftypes.NewValue(tftypes.String, "ci"),
+			"rotate_after":      tftypes.NewValue(tftypes.String, "2s"),
+			"rotated_at":        tftypes.NewValue(tftypes.String, rotatedAt),
+			"deletion_behavior": tftypes.NewValue(tftypes.String, "delete"),
+		}))
+		return &value
+	}
+	config, _ := tfprotov5.NewDynamicValue(keyType, objectWithNulls(keyType, map[string]tftypes.Value{
+		"key":          tftypes.NewValue(tftypes.String, "sk-current"),
+		"key_alias":    tftypes.NewValue(tftypes.String, "ci-2"),
+		"rotate_after": tftypes.NewValue(tftypes.String, "2s"),
+	}))
+	plan := func(prior *tfprotov5.DynamicValue) *tfprotov5.PlanResourceChangeResponse {
+		t.Helper()
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "litellm_key",
+			PriorState:       prior,
+			ProposedNewState: &config,
+			Config:           &config,
+		})
+		if err != nil {
+			t.Fatalf("PlanResourceChange failed: %v", err)
+		}
+		return planResp
+	}
+
+	// A due rotation must not reuse the current key
+	if resp := plan(state("2020-01-01T00:00:00Z")); len(resp.Diagnostics) == 0 || !strings.Contains(resp.Diagnostics[0].Summary, "still holds the current key") {
+		t.Fatalf("expected the plan to reject the current key, got %v", resp.Diagnostics)
+	}
+
+	// A plan made before rotate_after elapses is applied as planned after it
+	prior := state(time.Now().UTC().Add(time.Second).Format(time.RFC3339))
+	planResp := plan(prior)
+	if len(planResp.Diagnostics) > 0 {
+		t.Fatalf("PlanResourceChange failed: %v", planResp.Diagnostics)
+	}
+	time.Sleep(3 * time.Second)
+	applyResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       "litellm_key",
+		PriorState:     prior,
+		PlannedState:   planResp.PlannedState,
+		Config:         &config,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil || len(applyResp.Diagnostics) > 0 {
+		t.Fatalf("ApplyResourceChange failed: %v %v", err, applyResp.Diagnostics)
+	}
+	if regenerated {
+		t.Fatal("key rotated although the plan kept it")
+	}
+}
+
+func TestKeyBlockStateUsesBlockEndpoints(t *testing.T) {
+	var calls []string
+	var updateBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		calls = append(calls, r.URL.Path)
+		switch r.URL.Path {
+		case "/key/update":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			w.Write([]byte(`{}`))
+		case "/key/block", "/key/unblock", "/key/delete":
+			w.Write([]byte(`{}`))
+		case "/key/info":
+			w.Write([]byte(`{"key": "hash-1", "info": {"key_alias": "ci", "blocked": true}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	res := resourceKey()
+	state := &terraform.InstanceState{
+		ID: "hash-1",
+		Attributes: map[string]string{
+			"id":                "hash-1",
+			"key_alias":         "ci",
+			"blocked":           "false",
+			"deletion_behavior": "delete",
+			"rotated_at":        time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key_alias":         "ci",
+		"blocked":           true,
+		"deletion_behavior": "block",
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	newState, diags := res.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("apply failed: %v", diags)
+	}
+	if _, ok := updateBody["blocked"]; ok {
+		t.Fatalf("blocked must not be sent to /key/update: %v", updateBody)
+	}
+	if !slices.Contains(calls, "/key/block") {
+		t.Fatalf("expected /key/block to be called, got %v", calls)
+	}
+
+	calls = nil
+	d := res.Data(newState)
+	if diags := resourceKeyDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+	if !slices.Contains(calls, "/key/block") || slices.Contains(calls, "/key/delete") {
+		t.Fatalf("expected destroy to block instead of delete, got %v", calls)
+	}
+}
+
+func TestKeyStateUpgradeV0HashesRawKeyID(t *testing.T) {
+	rawKey := "sk-1234567890abcdef"
+	state, err := resourceKeyStateUpgradeV0(context.Background(), map[string]interface{}{
+		"id":        rawKey,
+		"key":       rawKey,
+		"key_alias": "ci",
+	}, nil)
+	if err != nil {
+		t.Fatalf("upgrade failed: %v", err)
+	}
+
+	want := hashKey(rawKey)
+	if state["id"] != want || state["token_id"] != want {
+		t.Fatalf("expected ID %s, got %v", want, state)
+	}
+	if _, ok := state["key"]; ok {
+		t.Fatalf("raw key left in state: %v", state)
+	}
+	if state["key_alias"] != "ci" {
+		t.Fatalf("unrelated attributes changed: %v", state)
+	}
+
+	// States already migrated by hand keep their token ID
+	state, _ = resourceKeyStateUpgradeV0(context.Background(), map[string]interface{}{"id": want}, nil)
+	if state["id"] != want {
+		t.Fatalf("token ID rewritten: %v", state)
+	}
+}
+
+func TestKeyStateUpgradeThroughProtocol(t *testing.T) {
+	rawKey := "sk-1234567890abcdef"
+	server := Provider().GRPCProvider()
+	resp, err := server.UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "litellm_key",
+		Version:  0,
+		RawState: &tfprotov5.RawState{
+			JSON: []byte(`{"id": "` + rawKey + `", "key": "` + rawKey + `", "key_alias": "ci", "models": ["gpt-4o"]}`),
+		},
+	})
+	if err != nil {
+		t.Fatalf("upgrade failed: %v", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas["litellm_key"].ValueType())
+	if err != nil {
+		t.Fatalf("failed to decode upgraded state: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	value.As(&attrs)
+	var id string
+	attrs["id"].As(&id)
+	if id != hashKey(rawKey) {
+		t.Fatalf("expected ID %s, got %s", hashKey(rawKey), id)
+	}
+	if !attrs["key"].IsNull() {
+		t.Fatalf("raw key left in upgraded state")
+	}
+}
@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -225,6 +226,36 @@ func resourceLiteLLMMCPServerRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceLiteLLMMCPServerImport imports an MCP server by ID, or by name when
+// the import ID has the form "server_name=<name>".
+func resourceLiteLLMMCPServerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if serverName, ok := parseImportAlias(d.Id(), "server_name"); ok {
+		serverID, err := findMCPServerIDByName(m.(*Client), serverName)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(serverID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findMCPServerIDByName looks up the ID of the MCP server with the given name.
+func findMCPServerIDByName(client *Client, serverName string) (string, error) {
+	servers, err := ListMCPServers(client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, server := range servers {
+		if server.ServerName == serverName {
+			ids = append(ids, server.ServerID)
+		}
+	}
+	return resolveImportAlias("MCP server", "server_name", serverName, ids)
+}
+
 func resourceLiteLLMMCPServerUpdate(d *schema.ResourceData, m interface{}) error {
 	client, ok := m.(*Client)
 	if !ok {
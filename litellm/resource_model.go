@@ -6,11 +6,16 @@ import (
 )
 
 func resourceLiteLLMModel() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceLiteLLMModelCreate,
 		Read:   resourceLiteLLMModelRead,
 		Update: resourceLiteLLMModelUpdate,
 		Delete: resourceLiteLLMModelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMModelImport,
+		},
+		CustomizeDiff: resourceLiteLLMModelCustomizeDiff,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"model_name": {
@@ -57,17 +62,33 @@ func resourceLiteLLMModel() *schema.Resource {
 				Optional: true,
 			},
 			"model_api_key": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"azure", "openai_compatible"},
+			},
+			"model_api_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"model_api_key", "azure", "openai_compatible"},
+				Description:   "Write-only model_api_key: sent to the proxy but never stored in state",
+			},
+			"model_api_key_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Change this value to resend model_api_key_wo to the proxy",
 			},
 			"model_api_base": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"azure", "openai_compatible"},
 			},
 			"api_version": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"azure"},
 			},
 			"base_model": {
 				Type:     schema.TypeString,
@@ -129,43 +150,67 @@ func resourceLiteLLMModel() *schema.Resource {
 				Optional: true,
 			},
 			"aws_access_key_id": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the aws block instead",
+				ConflictsWith:    []string{"aws"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"aws_secret_access_key": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the aws block instead",
+				ConflictsWith:    []string{"aws"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"aws_region_name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Deprecated:       "Use the aws block instead",
+				ConflictsWith:    []string{"aws"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"aws_session_name": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the aws block instead",
+				ConflictsWith:    []string{"aws"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"aws_role_name": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the aws block instead",
+				ConflictsWith:    []string{"aws"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"vertex_project": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the vertex block instead",
+				ConflictsWith:    []string{"vertex"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"vertex_location": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the vertex block instead",
+				ConflictsWith:    []string{"vertex"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"vertex_credentials": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Deprecated:       "Use the vertex block instead",
+				ConflictsWith:    []string{"vertex"},
+				DiffSuppressFunc: suppressUpgradedProviderAttributeDiff,
 			},
 			"litellm_credential_name": {
 				Type:        schema.TypeString,
@@ -180,6 +225,20 @@ func resourceLiteLLMModel() *schema.Resource {
 				},
 				Description: "Additional parameters to pass to litellm_params beyond the standard ones",
 			},
+			"model_info": modelInfoSchema(),
+		},
+	}
+	for name, block := range modelProviderBlockSchemas() {
+		r.Schema[name] = block
+	}
+
+	// Version 0 has the aws_* and vertex_* settings as top-level attributes
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceLiteLLMModelStateUpgradeV0,
 		},
 	}
+	return r
 }
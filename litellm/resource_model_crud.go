@@ -1,14 +1,13 @@
 package litellm
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"log"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -63,6 +62,79 @@ func createOrUpdateModel(d *schema.ResourceData, m interface{}, isUpdate bool) e
 		return fmt.Errorf("invalid type assertion for client")
 	}
 
+	// Generate a UUID for new models
+	modelID := d.Id()
+	if !isUpdate {
+		modelID = uuid.New().String()
+	}
+
+	if credentialName := d.Get("litellm_credential_name").(string); credentialName != "" && (!isUpdate || d.HasChange("litellm_credential_name")) {
+		if err := checkCredentialExists(client, credentialName); err != nil {
+			return err
+		}
+	}
+
+	modelReq := BuildModelRequest(d, modelID)
+	addModelWriteOnlySecrets(d, modelReq.LiteLLMParams, isUpdate)
+
+	endpoint := endpointModelNew
+	if isUpdate {
+		endpoint = endpointModelUpdate
+	}
+
+	resp, err := MakeRequest(client, "POST", endpoint, modelReq)
+	if err != nil {
+		return fmt.Errorf("failed to %s model: %w", map[bool]string{true: "update", false: "create"}[isUpdate], err)
+	}
+	defer resp.Body.Close()
+
+	_, err = handleAPIResponse(resp, modelReq, client)
+	if err != nil {
+		if isUpdate && err.Error() == "model_not_found" {
+			return createOrUpdateModel(d, m, false)
+		}
+		return fmt.Errorf("failed to %s model: %w", map[bool]string{true: "update", false: "create"}[isUpdate], err)
+	}
+
+	d.SetId(modelID)
+
+	log.Printf("[INFO] Model created with ID %s. Starting retry mechanism to read the model...", modelID)
+	// Read back the resource with retries to ensure the state is consistent
+	return retryModelRead(d, m, 5)
+}
+
+// addModelWriteOnlySecrets adds the configured write-only secrets to
+// litellm_params. They are sent on create and when their *_wo_version changes;
+// /model/update merges litellm_params, so the proxy keeps the stored secret
+// otherwise.
+func addModelWriteOnlySecrets(d *schema.ResourceData, litellmParams map[string]interface{}, isUpdate bool) {
+	send := func(path cty.Path, versionAttr, param string) {
+		if isUpdate && !d.HasChange(versionAttr) {
+			return
+		}
+		if secret := getWriteOnlyString(d, path); secret != "" {
+			litellmParams[param] = secret
+		}
+	}
+
+	send(cty.GetAttrPath("model_api_key_wo"), "model_api_key_wo_version", "api_key")
+	for _, block := range modelProviderBlocks {
+		if len(d.Get(block.name).([]interface{})) == 0 {
+			continue
+		}
+		for _, field := range block.fields {
+			if field.sensitive {
+				path := cty.GetAttrPath(block.name).IndexInt(0).GetAttr(field.name + "_wo")
+				send(path, fmt.Sprintf("%s.0.%s_wo_version", block.name, field.name), field.param)
+			}
+		}
+	}
+}
+
+// BuildModelRequest builds the /model/new and /model/update payload for a
+// litellm_model resource. tools/modelconfig uses it to render model_list
+// entries so the generated config.yaml matches what the provider sends.
+func BuildModelRequest(d *schema.ResourceData, modelID string) ModelRequest {
 	// Construct the model name in the format "custom_llm_provider/base_model"
 	customLLMProvider := d.Get("custom_llm_provider").(string)
 	baseModel := d.Get("base_model").(string)
@@ -76,12 +148,6 @@ func createOrUpdateModel(d *schema.ResourceData, m interface{}, isUpdate bool) e
 		pricingBaseModel = v.(string)
 	}
 
-	// Generate a UUID for new models
-	modelID := d.Id()
-	if !isUpdate {
-		modelID = uuid.New().String()
-	}
-
 	// Create thinking configuration if enabled
 	var thinking map[string]interface{}
 	if d.Get("thinking_enabled").(bool) {
@@ -164,77 +230,11 @@ func createOrUpdateModel(d *schema.ResourceData, m interface{}, isUpdate bool) e
 		litellmParams["thinking"] = thinking
 	}
 
+	expandModelProviderBlocks(d, litellmParams)
+
 	// Add additional parameters if provided
 	if additionalParams, ok := d.GetOk("additional_litellm_params"); ok {
-		var dropParams []string
-
-		for key, value := range additionalParams.(map[string]interface{}) {
-			// Convert string values to appropriate types where possible
-			if strValue, ok := value.(string); ok {
-				// Check if it's JSON (starts with [ or {)
-				trimmedValue := strings.TrimSpace(strValue)
-				if strings.HasPrefix(trimmedValue, "[") || strings.HasPrefix(trimmedValue, "{") {
-					var parsedValue interface{}
-					if err := json.Unmarshal([]byte(strValue), &parsedValue); err == nil {
-						// Successfully parsed JSON
-						if key == "additional_drop_params" {
-							// Handle drop params specially
-							if dropList, ok := parsedValue.([]interface{}); ok {
-								for _, item := range dropList {
-									if paramStr, ok := item.(string); ok {
-										dropParams = append(dropParams, paramStr)
-									}
-								}
-							}
-							continue // Don't add to litellmParams
-						} else {
-							litellmParams[key] = parsedValue
-						}
-					} else {
-						// Not valid JSON, apply existing conversion logic
-						if strValue == "true" {
-							litellmParams[key] = true
-						} else if strValue == "false" {
-							litellmParams[key] = false
-						} else {
-							// Try to convert numeric strings
-							if intValue, err := strconv.Atoi(strValue); err == nil {
-								litellmParams[key] = intValue
-							} else if floatValue, err := strconv.ParseFloat(strValue, 64); err == nil {
-								litellmParams[key] = floatValue
-							} else {
-								// Keep as string
-								litellmParams[key] = strValue
-							}
-						}
-					}
-				} else {
-					// Apply existing conversion logic for non-JSON strings
-					if strValue == "true" {
-						litellmParams[key] = true
-					} else if strValue == "false" {
-						litellmParams[key] = false
-					} else {
-						// Try to convert numeric strings
-						if intValue, err := strconv.Atoi(strValue); err == nil {
-							litellmParams[key] = intValue
-						} else if floatValue, err := strconv.ParseFloat(strValue, 64); err == nil {
-							litellmParams[key] = floatValue
-						} else {
-							// Keep as string
-							litellmParams[key] = strValue
-						}
-					}
-				}
-			} else {
-				litellmParams[key] = value
-			}
-		}
-
-		// Apply drop params at the end
-		for _, paramToDrop := range dropParams {
-			delete(litellmParams, paramToDrop)
-		}
+		addAdditionalLiteLLMParams(litellmParams, additionalParams.(map[string]interface{}))
 	}
 
 	// Add litellm_credential_name to litellmParams if provided
@@ -255,65 +255,102 @@ func createOrUpdateModel(d *schema.ResourceData, m interface{}, isUpdate bool) e
 		},
 		Additional: make(map[string]interface{}),
 	}
+	expandModelInfo(d, &modelReq.ModelInfo)
 
-	endpoint := endpointModelNew
-	if isUpdate {
-		endpoint = endpointModelUpdate
-	}
+	return modelReq
+}
 
-	resp, err := MakeRequest(client, "POST", endpoint, modelReq)
-	if err != nil {
-		return fmt.Errorf("failed to %s model: %w", map[bool]string{true: "update", false: "create"}[isUpdate], err)
-	}
-	defer resp.Body.Close()
+// addAdditionalLiteLLMParams merges additional_litellm_params into
+// litellm_params. String values are parsed with parseAdditionalParamValue and
+// the params listed in additional_drop_params are removed at the end.
+func addAdditionalLiteLLMParams(litellmParams map[string]interface{}, additionalParams map[string]interface{}) {
+	var dropParams []string
+
+	for key, value := range additionalParams {
+		strValue, ok := value.(string)
+		if !ok {
+			litellmParams[key] = value
+			continue
+		}
 
-	_, err = handleAPIResponse(resp, modelReq, client)
-	if err != nil {
-		if isUpdate && err.Error() == "model_not_found" {
-			return createOrUpdateModel(d, m, false)
+		parsedValue := parseAdditionalParamValue(strValue)
+		if key == "additional_drop_params" {
+			// Handle drop params specially
+			if dropList, ok := parsedValue.([]interface{}); ok {
+				for _, item := range dropList {
+					if paramStr, ok := item.(string); ok {
+						dropParams = append(dropParams, paramStr)
+					}
+				}
+				continue // Don't add to litellmParams
+			}
 		}
-		return fmt.Errorf("failed to %s model: %w", map[bool]string{true: "update", false: "create"}[isUpdate], err)
+		litellmParams[key] = parsedValue
 	}
 
-	d.SetId(modelID)
-
-	log.Printf("[INFO] Model created with ID %s. Starting retry mechanism to read the model...", modelID)
-	// Read back the resource with retries to ensure the state is consistent
-	return retryModelRead(d, m, 5)
+	// Apply drop params at the end
+	for _, paramToDrop := range dropParams {
+		delete(litellmParams, paramToDrop)
+	}
 }
 
 func resourceLiteLLMModelCreate(d *schema.ResourceData, m interface{}) error {
 	return createOrUpdateModel(d, m, false)
 }
 
-func resourceLiteLLMModelRead(d *schema.ResourceData, m interface{}) error {
-	client, ok := m.(*Client)
-	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
-	}
-
-	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?litellm_model_id=%s", endpointModelInfo, d.Id()), nil)
+// getModel fetches a model deployment by ID. It returns nil when the model
+// does not exist.
+func getModel(client *Client, modelID string) (*ModelResponse, error) {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?litellm_model_id=%s", endpointModelInfo, modelID), nil)
 	if err != nil {
-		return fmt.Errorf("failed to read model: %w", err)
+		return nil, fmt.Errorf("failed to read model: %w", err)
 	}
 	defer resp.Body.Close()
 
 	modelResp, err := handleAPIResponse(resp, nil, client)
 	if err != nil {
 		if err.Error() == "model_not_found" {
-			d.SetId("")
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("failed to read model: %w", err)
+		return nil, fmt.Errorf("failed to read model: %w", err)
+	}
+	return modelResp, nil
+}
+
+func resourceLiteLLMModelRead(d *schema.ResourceData, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok {
+		return fmt.Errorf("invalid type assertion for client")
 	}
 
+	modelResp, err := getModel(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if modelResp == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// litellm_params.model is "<custom_llm_provider>/<base_model>"; use it when
+	// the proxy does not return the parts separately (e.g. UI-created models)
+	routedProvider, routedModel := splitModelRoute(modelResp.LiteLLMParams.Model)
+
 	// Update the state with values from the response or fall back to the data passed in during creation
 	d.Set("model_name", GetStringValue(modelResp.ModelName, d.Get("model_name").(string)))
-	d.Set("custom_llm_provider", GetStringValue(modelResp.LiteLLMParams.CustomLLMProvider, d.Get("custom_llm_provider").(string)))
+	d.Set("custom_llm_provider", GetStringValue(modelResp.LiteLLMParams.CustomLLMProvider, GetStringValue(d.Get("custom_llm_provider").(string), routedProvider)))
 	d.Set("tpm", GetIntValue(modelResp.LiteLLMParams.TPM, d.Get("tpm").(int)))
 	d.Set("rpm", GetIntValue(modelResp.LiteLLMParams.RPM, d.Get("rpm").(int)))
-	d.Set("model_api_base", GetStringValue(modelResp.LiteLLMParams.APIBase, d.Get("model_api_base").(string)))
-	d.Set("api_version", GetStringValue(modelResp.LiteLLMParams.APIVersion, d.Get("api_version").(string)))
+
+	// Provider blocks take over the top-level attributes they mirror
+	inBlock := setModelProviderBlocks(d, modelResp.LiteLLMParams)
+	setFlat := func(attr, returned string) {
+		if !inBlock[attr] {
+			d.Set(attr, GetStringValue(returned, d.Get(attr).(string)))
+		}
+	}
+	setFlat("model_api_base", modelResp.LiteLLMParams.APIBase)
+	setFlat("api_version", modelResp.LiteLLMParams.APIVersion)
 	// base_model / pricing_base_model read-back. When pricing_base_model is
 	// configured, model_info.base_model holds the PRICING key, so recover the
 	// routing base_model from state (not returned by the API) and read
@@ -322,26 +359,37 @@ func resourceLiteLLMModelRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("base_model", d.Get("base_model").(string))
 		d.Set("pricing_base_model", GetStringValue(modelResp.ModelInfo.BaseModel, pbm.(string)))
 	} else {
-		d.Set("base_model", GetStringValue(modelResp.ModelInfo.BaseModel, d.Get("base_model").(string)))
+		d.Set("base_model", GetStringValue(modelResp.ModelInfo.BaseModel, GetStringValue(d.Get("base_model").(string), routedModel)))
 	}
 	d.Set("tier", GetStringValue(modelResp.ModelInfo.Tier, d.Get("tier").(string)))
 	d.Set("mode", GetStringValue(modelResp.ModelInfo.Mode, d.Get("mode").(string)))
 	d.Set("team_id", GetStringValue(modelResp.ModelInfo.TeamID, d.Get("team_id").(string)))
+	if modelInfo := flattenModelInfo(modelResp.ModelInfo); modelInfo != nil {
+		d.Set("model_info", modelInfo)
+	}
 
-	// Preserve credential name from state since it might not be returned by API
-	d.Set("litellm_credential_name", d.Get("litellm_credential_name").(string))
+	d.Set("reasoning_effort", GetStringValue(modelResp.LiteLLMParams.ReasoningEffort, d.Get("reasoning_effort").(string)))
+	setFlat("vertex_project", modelResp.LiteLLMParams.VertexProject)
+	setFlat("vertex_location", modelResp.LiteLLMParams.VertexLocation)
+	d.Set("litellm_credential_name", GetStringValue(modelResp.LiteLLMParams.LiteLLMCredentialName, d.Get("litellm_credential_name").(string)))
 
-	// Store sensitive information
-	d.Set("model_api_key", d.Get("model_api_key"))
-	d.Set("aws_access_key_id", d.Get("aws_access_key_id"))
-	d.Set("aws_secret_access_key", d.Get("aws_secret_access_key"))
-	d.Set("aws_region_name", GetStringValue(modelResp.LiteLLMParams.AWSRegionName, d.Get("aws_region_name").(string)))
-	d.Set("aws_session_name", d.Get("aws_session_name"))
-	d.Set("aws_role_name", d.Get("aws_role_name"))
+	// Secrets are not returned by the proxy and stay as they are in state
+	setFlat("aws_region_name", modelResp.LiteLLMParams.AWSRegionName)
+	setFlat("aws_session_name", modelResp.LiteLLMParams.AWSSessionName)
+	setFlat("aws_role_name", modelResp.LiteLLMParams.AWSRoleName)
 
-	// Store cost information
-	d.Set("input_cost_per_million_tokens", d.Get("input_cost_per_million_tokens"))
-	d.Set("output_cost_per_million_tokens", d.Get("output_cost_per_million_tokens"))
+	// Store cost information. Per-token costs are converted back to per-million
+	// only when state has no value, so float rounding does not show up as drift.
+	if v := d.Get("input_cost_per_million_tokens").(float64); v == 0 && modelResp.LiteLLMParams.InputCostPerToken > 0 {
+		d.Set("input_cost_per_million_tokens", modelResp.LiteLLMParams.InputCostPerToken*1000000.0)
+	}
+	if v := d.Get("output_cost_per_million_tokens").(float64); v == 0 && modelResp.LiteLLMParams.OutputCostPerToken > 0 {
+		d.Set("output_cost_per_million_tokens", modelResp.LiteLLMParams.OutputCostPerToken*1000000.0)
+	}
+	d.Set("input_cost_per_pixel", GetFloatValue(modelResp.LiteLLMParams.InputCostPerPixel, d.Get("input_cost_per_pixel").(float64)))
+	d.Set("output_cost_per_pixel", GetFloatValue(modelResp.LiteLLMParams.OutputCostPerPixel, d.Get("output_cost_per_pixel").(float64)))
+	d.Set("input_cost_per_second", GetFloatValue(modelResp.LiteLLMParams.InputCostPerSecond, d.Get("input_cost_per_second").(float64)))
+	d.Set("output_cost_per_second", GetFloatValue(modelResp.LiteLLMParams.OutputCostPerSecond, d.Get("output_cost_per_second").(float64)))
 
 	// Handle thinking configuration
 	if _, ok := d.GetOk("thinking_enabled"); ok {
@@ -388,6 +436,36 @@ func resourceLiteLLMModelRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceLiteLLMModelImport imports a model by ID, or by name when the import
+// ID has the form "model_name=<name>" and exactly one deployment uses that name.
+func resourceLiteLLMModelImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if modelName, ok := parseImportAlias(d.Id(), "model_name"); ok {
+		modelID, err := findModelIDByName(m.(*Client), modelName)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(modelID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findModelIDByName looks up the ID of the model deployment with the given name.
+func findModelIDByName(client *Client, modelName string) (string, error) {
+	models, err := ListModels(client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, model := range models {
+		if model.ModelName == modelName && model.ModelInfo.ID != "" {
+			ids = append(ids, model.ModelInfo.ID)
+		}
+	}
+	return resolveImportAlias("model", "model_name", modelName, ids)
+}
+
 func resourceLiteLLMModelUpdate(d *schema.ResourceData, m interface{}) error {
 	return createOrUpdateModel(d, m, true)
 }
@@ -0,0 +1,601 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestModelImportByNameReadsAttributes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/model/info" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("litellm_model_id") == "m-1" {
+			w.Write([]byte(`{"data": [{
+				"model_name": "gpt-4o",
+				"litellm_params": {
+					"model": "openai/gpt-4o",
+					"api_key": "sk-secret",
+					"input_cost_per_token": 0.0000025,
+					"rpm": 100,
+					"litellm_credential_name": "openai-prod"
+				},
+				"model_info": {"id": "m-1", "db_model": true, "mode": "chat"}
+			}]}`))
+			return
+		}
+		w.Write([]byte(`{"data": [
+			{"model_name": "gpt-4o", "litellm_params": {"model": "openai/gpt-4o"}, "model_info": {"id": "m-1"}},
+			{"model_name": "claude", "litellm_params": {"model": "anthropic/claude-sonnet-4"}, "model_info": {"id": "m-2"}},
+			{"model_name": "claude", "litellm_params": {"model": "bedrock/claude-sonnet-4"}, "model_info": {"id": "m-3"}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModel().Schema, map[string]interface{}{})
+	d.SetId("model_name=gpt-4o")
+
+	if _, err := resourceLiteLLMModelImport(context.Background(), d, client); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if d.Id() != "m-1" {
+		t.Fatalf("expected model name to resolve to m-1, got %q", d.Id())
+	}
+	if err := resourceLiteLLMModelRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if d.Get("model_name").(string) != "gpt-4o" {
+		t.Fatalf("expected model_name gpt-4o, got %q", d.Get("model_name"))
+	}
+	if d.Get("custom_llm_provider").(string) != "openai" || d.Get("base_model").(string) != "gpt-4o" {
+		t.Fatalf("expected provider and base model to be derived from litellm_params.model, got %q and %q",
+			d.Get("custom_llm_provider"), d.Get("base_model"))
+	}
+	if d.Get("rpm").(int) != 100 || d.Get("mode").(string) != "chat" {
+		t.Fatalf("expected rpm and mode to be read back, got %v and %q", d.Get("rpm"), d.Get("mode"))
+	}
+	if cost := d.Get("input_cost_per_million_tokens").(float64); cost < 2.4999 || cost > 2.5001 {
+		t.Fatalf("expected input cost of 2.5 per million tokens, got %v", cost)
+	}
+	if d.Get("litellm_credential_name").(string) != "openai-prod" {
+		t.Fatalf("expected litellm_credential_name to be read back, got %q", d.Get("litellm_credential_name"))
+	}
+	if d.Get("model_api_key").(string) != "" {
+		t.Fatal("server-returned api_key persisted into state")
+	}
+
+	d.SetId("model_name=claude")
+	if _, err := resourceLiteLLMModelImport(context.Background(), d, client); err == nil {
+		t.Fatal("expected an error for a model name shared by several deployments")
+	}
+}
+
+func TestModelInfoBlockSentAndReadBack(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_name":          "claude",
+		"custom_llm_provider": "anthropic",
+		"base_model":          "claude-sonnet-4",
+		"model_info": []interface{}{map[string]interface{}{
+			"max_input_tokens":            200000,
+			"supports_vision":             false,
+			"access_groups":               []interface{}{"beta"},
+			"cache_read_input_token_cost": 0.0000003,
+		}},
+	})
+
+	body, err := json.Marshal(BuildModelRequest(d, "m-1").ModelInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload map[string]interface{}
+	json.Unmarshal(body, &payload)
+	if payload["max_input_tokens"] != 200000.0 || payload["supports_vision"] != false || payload["cache_read_input_token_cost"] != 0.0000003 {
+		t.Fatalf("model_info fields not sent: %v", payload)
+	}
+	for _, unset := range []string{"max_output_tokens", "supports_function_calling", "input_cost_per_token_above_200k_tokens"} {
+		if _, ok := payload[unset]; ok {
+			t.Fatalf("unset %s sent, overriding the proxy's cost map: %v", unset, payload)
+		}
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{
+			"model_name": "claude",
+			"litellm_params": {"model": "anthropic/claude-sonnet-4"},
+			"model_info": {"id": "m-1", "db_model": true, "max_input_tokens": 200000, "max_output_tokens": 64000,
+				"supports_vision": true, "supports_prompt_caching": true, "access_groups": ["beta"],
+				"cache_read_input_token_cost": 3e-07, "input_cost_per_token_above_200k_tokens": 6e-06}
+		}]}`))
+	}))
+	defer srv.Close()
+
+	d.SetId("m-1")
+	if err := resourceLiteLLMModelRead(d, NewClient(srv.URL, "test-key", true)); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if d.Get("model_info.0.max_output_tokens").(int) != 64000 || !d.Get("model_info.0.supports_vision").(bool) {
+		t.Fatalf("model_info not read back: %v", d.Get("model_info"))
+	}
+	if d.Get("model_info.0.input_cost_per_token_above_200k_tokens").(float64) != 6e-06 {
+		t.Fatalf("tiered pricing not read back: %v", d.Get("model_info"))
+	}
+}
+
+func TestModelInfoSendsOnlyConfiguredFields(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/model/new", "/model/update":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			sent = append(sent, body["model_info"].(map[string]interface{}))
+			w.Write([]byte(`{}`))
+		case "/model/info":
+			w.Write([]byte(`{"data": [{
+				"model_name": "gpt-4o",
+				"litellm_params": {"model": "openai/gpt-4o"},
+				"model_info": {"id": "` + r.URL.Query().Get("litellm_model_id") + `", "max_tokens": 1000,
+					"max_input_tokens": 128000, "supports_vision": true, "cache_read_input_token_cost": 1.25e-06}
+			}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	providerConfig, _ := tfprotov5.NewDynamicValue(providerType, objectWithNulls(providerType, map[string]tftypes.Value{
+		"api_base": tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	}))
+	if resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig}); err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, resp.Diagnostics)
+	}
+
+	modelType := schemaResp.ResourceSchemas["litellm_model"].ValueType().(tftypes.Object)
+	infoType := modelType.AttributeTypes["model_info"].(tftypes.List).ElementType.(tftypes.Object)
+	config := func(rpm int) tftypes.Value {
+		return objectWithNulls(modelType, map[string]tftypes.Value{
+			"model_name":          tftypes.NewValue(tftypes.String, "gpt-4o"),
+			"custom_llm_provider": tftypes.NewValue(tftypes.String, "openai"),
+			"base_model":          tftypes.NewValue(tftypes.String, "gpt-4o"),
+			"rpm":                 tftypes.NewValue(tftypes.Number, rpm),
+			"model_info": tftypes.NewValue(modelType.AttributeTypes["model_info"], []tftypes.Value{
+				objectWithNulls(infoType, map[string]tftypes.Value{
+					"max_tokens": tftypes.NewValue(tftypes.Number, 1000),
+				}),
+			}),
+		})
+	}
+
+	prior := tftypes.NewValue(modelType, nil)
+	for _, rpm := range []int{100, 200} {
+		priorState, _ := tfprotov5.NewDynamicValue(modelType, prior)
+		configValue, _ := tfprotov5.NewDynamicValue(modelType, config(rpm))
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "litellm_model",
+			PriorState:       &priorState,
+			ProposedNewState: &configValue,
+			Config:           &configValue,
+		})
+		if err != nil || len(planResp.Diagnostics) > 0 {
+			t.Fatalf("PlanResourceChange failed: %v %v", err, planResp.Diagnostics)
+		}
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       "litellm_model",
+			PriorState:     &priorState,
+			PlannedState:   planResp.PlannedState,
+			Config:         &configValue,
+			PlannedPrivate: planResp.PlannedPrivate,
+		})
+		if err != nil || len(applyResp.Diagnostics) > 0 {
+			t.Fatalf("ApplyResourceChange failed: %v %v", err, applyResp.Diagnostics)
+		}
+		prior, err = applyResp.NewState.Unmarshal(modelType)
+		if err != nil {
+			t.Fatalf("failed to decode new state: %v", err)
+		}
+	}
+
+	if len(sent) != 2 {
+		t.Fatalf("expected a create and an update, got %v", sent)
+	}
+	for _, info := range sent {
+		for _, attr := range []string{"max_input_tokens", "supports_vision", "cache_read_input_token_cost"} {
+			if _, ok := info[attr]; ok {
+				t.Fatalf("cost-map value %s sent back to the proxy: %v", attr, info)
+			}
+		}
+		if info["max_tokens"] != 1000.0 {
+			t.Fatalf("configured max_tokens not sent: %v", info)
+		}
+	}
+}
+
+func TestModelProviderBlocksValidatedAtPlan(t *testing.T) {
+	res := resourceLiteLLMModel()
+	for name, tc := range map[string]struct {
+		config  map[string]interface{}
+		wantErr string
+	}{
+		"aws block on openai": {
+			config: map[string]interface{}{
+				"custom_llm_provider": "openai",
+				"aws":                 []interface{}{map[string]interface{}{"region_name": "us-east-1"}},
+			},
+			wantErr: "use the openai_compatible block instead",
+		},
+		"deprecated aws attribute on openai": {
+			config: map[string]interface{}{
+				"custom_llm_provider": "openai",
+				"aws_region_name":     "us-east-1",
+			},
+			wantErr: "aws_region_name is not used",
+		},
+		"vertex block without project": {
+			config: map[string]interface{}{
+				"custom_llm_provider": "vertex_ai",
+				"vertex":              []interface{}{map[string]interface{}{"location": "us-central1"}},
+			},
+			wantErr: "vertex.project is required",
+		},
+		"vertex without block or deprecated project": {
+			config: map[string]interface{}{
+				"custom_llm_provider": "vertex_ai",
+			},
+			wantErr: "vertex.project is required",
+		},
+		"deprecated vertex attributes on vertex": {
+			config: map[string]interface{}{
+				"custom_llm_provider": "vertex_ai",
+				"vertex_project":      "acme",
+				"vertex_location":     "us-central1",
+			},
+		},
+		"complete aws block on bedrock": {
+			config: map[string]interface{}{
+				"custom_llm_provider": "bedrock",
+				"aws":                 []interface{}{map[string]interface{}{"region_name": "us-east-1", "role_name": "arn:aws:iam::123:role/llm"}},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.config["model_name"] = "m"
+			tc.config["base_model"] = "b"
+			_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestModelProviderBlocksSentAndImported(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_name":          "claude",
+		"custom_llm_provider": "bedrock",
+		"base_model":          "anthropic.claude-3-5-sonnet",
+		"aws": []interface{}{map[string]interface{}{
+			"region_name":       "eu-west-1",
+			"secret_access_key": "aws-secret",
+		}},
+	})
+	params := BuildModelRequest(d, "m-1").LiteLLMParams
+	if params["aws_region_name"] != "eu-west-1" || params["aws_secret_access_key"] != "aws-secret" {
+		t.Fatalf("aws block not sent: %v", params)
+	}
+	if _, ok := params["aws_role_name"]; ok {
+		t.Fatalf("unset aws.role_name sent: %v", params)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{
+			"model_name": "gemini",
+			"litellm_params": {"model": "vertex_ai/gemini-2.5-pro", "vertex_project": "acme", "vertex_location": "us-central1"},
+			"model_info": {"id": "m-2"}
+		}]}`))
+	}))
+	defer srv.Close()
+
+	imported := schema.TestResourceDataRaw(t, resourceLiteLLMModel().Schema, map[string]interface{}{})
+	imported.SetId("m-2")
+	if err := resourceLiteLLMModelRead(imported, NewClient(srv.URL, "test-key", true)); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if imported.Get("vertex.0.project").(string) != "acme" || imported.Get("vertex.0.location").(string) != "us-central1" {
+		t.Fatalf("vertex block not populated on import: %v", imported.Get("vertex"))
+	}
+	if imported.Get("vertex_project").(string) != "" {
+		t.Fatal("deprecated vertex_project set alongside the vertex block")
+	}
+}
+
+func TestModelStateUpgradeV0MovesProviderAttributes(t *testing.T) {
+	state, err := resourceLiteLLMModelStateUpgradeV0(context.Background(), map[string]interface{}{
+		"id":                    "m-1",
+		"custom_llm_provider":   "bedrock",
+		"aws_region_name":       "us-east-1",
+		"aws_secret_access_key": "aws-secret",
+		"vertex_project":        "",
+	}, nil)
+	if err != nil {
+		t.Fatalf("upgrade failed: %v", err)
+	}
+
+	aws, ok := state["aws"].([]interface{})
+	if !ok || len(aws) != 1 {
+		t.Fatalf("aws block not created: %v", state)
+	}
+	block := aws[0].(map[string]interface{})
+	if block["region_name"] != "us-east-1" || block["secret_access_key"] != "aws-secret" {
+		t.Fatalf("aws attributes not moved: %v", block)
+	}
+	for _, attr := range []string{"aws_region_name", "aws_secret_access_key", "vertex_project"} {
+		if _, ok := state[attr]; ok {
+			t.Fatalf("deprecated %s left in upgraded state", attr)
+		}
+	}
+	if _, ok := state["vertex"]; ok {
+		t.Fatalf("empty vertex block created: %v", state["vertex"])
+	}
+}
+
+func TestModelUpgradedStatePlansNoChangesForDeprecatedAttributes(t *testing.T) {
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	modelType := schemaResp.ResourceSchemas["litellm_model"].ValueType().(tftypes.Object)
+
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "litellm_model",
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: []byte(`{
+			"id": "m-1", "model_name": "claude", "custom_llm_provider": "bedrock",
+			"base_model": "anthropic.claude-3-5-sonnet", "aws_region_name": "us-east-1"
+		}`)},
+	})
+	if err != nil || len(upgradeResp.Diagnostics) > 0 {
+		t.Fatalf("UpgradeResourceState failed: %v %v", err, upgradeResp.Diagnostics)
+	}
+
+	config, _ := tfprotov5.NewDynamicValue(modelType, objectWithNulls(modelType, map[string]tftypes.Value{
+		"model_name":          tftypes.NewValue(tftypes.String, "claude"),
+		"custom_llm_provider": tftypes.NewValue(tftypes.String, "bedrock"),
+		"base_model":          tftypes.NewValue(tftypes.String, "anthropic.claude-3-5-sonnet"),
+		"aws_region_name":     tftypes.NewValue(tftypes.String, "us-east-1"),
+	}))
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "litellm_model",
+		PriorState:       upgradeResp.UpgradedState,
+		ProposedNewState: &config,
+		Config:           &config,
+	})
+	if err != nil || len(planResp.Diagnostics) > 0 {
+		t.Fatalf("PlanResourceChange failed: %v %v", err, planResp.Diagnostics)
+	}
+
+	planned, _ := planResp.PlannedState.Unmarshal(modelType)
+	var attrs map[string]tftypes.Value
+	planned.As(&attrs)
+	var aws []tftypes.Value
+	attrs["aws"].As(&aws)
+	if len(aws) != 1 {
+		t.Fatalf("upgraded aws block planned for removal: %v", attrs["aws"])
+	}
+	var region string
+	attrs["aws_region_name"].As(&region)
+	if region != "" {
+		t.Fatalf("deprecated aws_region_name planned back into state: %q", region)
+	}
+}
+
+func TestModelReadKeepsDeprecatedProviderAttributes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{
+			"model_name": "claude",
+			"litellm_params": {"custom_llm_provider": "bedrock", "model": "bedrock/anthropic.claude-3-5-sonnet", "aws_region_name": "us-west-2"},
+			"model_info": {"id": "m-1"}
+		}]}`))
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_name":            "claude",
+		"custom_llm_provider":   "bedrock",
+		"base_model":            "anthropic.claude-3-5-sonnet",
+		"aws_region_name":       "us-east-1",
+		"aws_secret_access_key": "aws-secret",
+	})
+	d.SetId("m-1")
+	if err := resourceLiteLLMModelRead(d, NewClient(srv.URL, "test-key", true)); err != nil {
+		t.Fatal(err)
+	}
+
+	if aws := d.Get("aws").([]interface{}); len(aws) != 0 {
+		t.Fatalf("aws block adopted for a model using the deprecated attributes: %v", aws)
+	}
+	if d.Get("aws_region_name").(string) != "us-west-2" || d.Get("aws_secret_access_key").(string) != "aws-secret" {
+		t.Fatalf("deprecated attributes not kept: %v", d.State())
+	}
+}
+
+// objectWithNulls builds a value of an object type with the given attributes
+// set and every other attribute null.
+func objectWithNulls(typ tftypes.Object, set map[string]tftypes.Value) tftypes.Value {
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := set[name]; ok {
+			attrs[name] = v
+		}
+	}
+	return tftypes.NewValue(typ, attrs)
+}
+
+func TestModelWriteOnlySecretsSentOnCreateAndVersionChange(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/model/new", "/model/update":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			sent = append(sent, body["litellm_params"].(map[string]interface{}))
+			w.Write([]byte(`{}`))
+		case "/model/info":
+			w.Write([]byte(`{"data": [{
+				"model_name": "claude",
+				"litellm_params": {"model": "bedrock/anthropic.claude-3-5-sonnet", "aws_region_name": "us-east-1"},
+				"model_info": {"id": "` + r.URL.Query().Get("litellm_model_id") + `"}
+			}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	providerConfig, _ := tfprotov5.NewDynamicValue(providerType, objectWithNulls(providerType, map[string]tftypes.Value{
+		"api_base": tftypes.NewValue(tftypes.String, srv.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	}))
+	if resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig}); err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider failed: %v %v", err, resp.Diagnostics)
+	}
+
+	modelType := schemaResp.ResourceSchemas["litellm_model"].ValueType().(tftypes.Object)
+	awsType := modelType.AttributeTypes["aws"].(tftypes.List).ElementType.(tftypes.Object)
+	config := func(rpm, version int) tftypes.Value {
+		return objectWithNulls(modelType, map[string]tftypes.Value{
+			"model_name":          tftypes.NewValue(tftypes.String, "claude"),
+			"custom_llm_provider": tftypes.NewValue(tftypes.String, "bedrock"),
+			"base_model":          tftypes.NewValue(tftypes.String, "anthropic.claude-3-5-sonnet"),
+			"rpm":                 tftypes.NewValue(tftypes.Number, rpm),
+			"aws": tftypes.NewValue(modelType.AttributeTypes["aws"], []tftypes.Value{
+				objectWithNulls(awsType, map[string]tftypes.Value{
+					"region_name":                  tftypes.NewValue(tftypes.String, "us-east-1"),
+					"secret_access_key_wo":         tftypes.NewValue(tftypes.String, "aws-secret"),
+					"secret_access_key_wo_version": tftypes.NewValue(tftypes.Number, version),
+				}),
+			}),
+		})
+	}
+
+	prior := tftypes.NewValue(modelType, nil)
+	apply := func(cfg tftypes.Value) map[string]interface{} {
+		t.Helper()
+		priorState, _ := tfprotov5.NewDynamicValue(modelType, prior)
+		configValue, _ := tfprotov5.NewDynamicValue(modelType, cfg)
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "litellm_model",
+			PriorState:       &priorState,
+			ProposedNewState: &configValue,
+			Config:           &configValue,
+		})
+		if err != nil || len(planResp.Diagnostics) > 0 {
+			t.Fatalf("PlanResourceChange failed: %v %v", err, planResp.Diagnostics)
+		}
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       "litellm_model",
+			PriorState:     &priorState,
+			PlannedState:   planResp.PlannedState,
+			Config:         &configValue,
+			PlannedPrivate: planResp.PlannedPrivate,
+		})
+		if err != nil || len(applyResp.Diagnostics) > 0 {
+			t.Fatalf("ApplyResourceChange failed: %v %v", err, applyResp.Diagnostics)
+		}
+		prior, err = applyResp.NewState.Unmarshal(modelType)
+		if err != nil {
+			t.Fatalf("failed to decode new state: %v", err)
+		}
+		return sent[len(sent)-1]
+	}
+
+	if params := apply(config(100, 1)); params["aws_secret_access_key"] != "aws-secret" {
+		t.Fatalf("write-only secret not sent on create: %v", params)
+	}
+	var attrs map[string]tftypes.Value
+	prior.As(&attrs)
+	var aws []tftypes.Value
+	attrs["aws"].As(&aws)
+	var awsAttrs map[string]tftypes.Value
+	aws[0].As(&awsAttrs)
+	if !awsAttrs["secret_access_key_wo"].IsNull() {
+		t.Fatalf("write-only secret stored in state: %v", awsAttrs["secret_access_key_wo"])
+	}
+
+	if params := apply(config(200, 1)); params["aws_secret_access_key"] != nil {
+		t.Fatalf("write-only secret resent without a version change: %v", params)
+	}
+	if params := apply(config(200, 2)); params["aws_secret_access_key"] != "aws-secret" {
+		t.Fatalf("write-only secret not resent after the version change: %v", params)
+	}
+}
+
+func TestModelCreateFailsForMissingCredential(t *testing.T) {
+	var created bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.EscapedPath() {
+		case "/credentials/by_name/team-a%2Fopenai-prdo":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Credential not found"}`))
+		case "/model/new":
+			created = true
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModel().Schema, map[string]interface{}{
+		"model_name":              "gpt-4o",
+		"custom_llm_provider":     "openai",
+		"base_model":              "gpt-4o",
+		"litellm_credential_name": "team-a/openai-prdo",
+	})
+	err := resourceLiteLLMModelCreate(d, NewClient(srv.URL, "test-key", true))
+	if err == nil || !strings.Contains(err.Error(), `litellm_credential_name "team-a/openai-prdo" does not match any credential`) {
+		t.Fatalf("expected a missing credential error, got %v", err)
+	}
+	if created {
+		t.Fatal("model created with a missing credential")
+	}
+}
@@ -0,0 +1,114 @@
+package litellm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLiteLLMModelGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiteLLMModelGroupCreate,
+		Read:   resourceLiteLLMModelGroupRead,
+		Update: resourceLiteLLMModelGroupUpdate,
+		Delete: resourceLiteLLMModelGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceLiteLLMModelGroupCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"model_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Public model name shared by every deployment of the group",
+			},
+			"deployment": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Deployments the proxy load-balances model_name across",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "Stable identifier of the deployment within the group. Changing it replaces the deployment",
+						},
+						"custom_llm_provider": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Provider the deployment routes to",
+						},
+						"base_model": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Model identifier at the provider",
+						},
+						"weight": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Relative share of traffic for weighted load balancing",
+						},
+						"order": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Priority of the deployment. The router uses deployments with a lower order first and falls back to higher ones",
+						},
+						"rpm": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Requests per minute the deployment accepts",
+						},
+						"tpm": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Tokens per minute the deployment accepts",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the deployment, used for region-based routing",
+						},
+						"api_base": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base URL of the provider API",
+						},
+						"api_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "API version of the provider",
+						},
+						"model_api_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "API key of the provider. Prefer litellm_credential_name to keep it out of state",
+						},
+						"litellm_credential_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the LiteLLM credential to use",
+						},
+						"additional_litellm_params": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional litellm_params, converted like litellm_model.additional_litellm_params",
+						},
+					},
+				},
+			},
+			"deployment_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Model IDs of the deployments, keyed by deployment key",
+			},
+		},
+	}
+}
@@ -0,0 +1,363 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLiteLLMModelGroupCreate(d *schema.ResourceData, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok {
+		return fmt.Errorf("invalid type assertion for client")
+	}
+
+	d.SetId(d.Get("model_name").(string))
+	if err := reconcileModelGroup(d, client, nil, map[string]interface{}{}); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Model group %s created", d.Id())
+	return readModelGroup(d, client, false)
+}
+
+func resourceLiteLLMModelGroupRead(d *schema.ResourceData, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok {
+		return fmt.Errorf("invalid type assertion for client")
+	}
+
+	return readModelGroup(d, client, true)
+}
+
+func resourceLiteLLMModelGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok {
+		return fmt.Errorf("invalid type assertion for client")
+	}
+
+	oldDeployments, _ := d.GetChange("deployment")
+	if err := reconcileModelGroup(d, client, oldDeployments.([]interface{}), d.Get("deployment_ids").(map[string]interface{})); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Model group %s updated", d.Id())
+	return readModelGroup(d, client, false)
+}
+
+func resourceLiteLLMModelGroupDelete(d *schema.ResourceData, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok {
+		return fmt.Errorf("invalid type assertion for client")
+	}
+
+	ids := d.Get("deployment_ids").(map[string]interface{})
+	for key, id := range ids {
+		if err := deleteModelGroupDeployment(client, id.(string)); err != nil {
+			d.Set("deployment_ids", ids)
+			return fmt.Errorf("failed to delete deployment %q of model group %s: %w", key, d.Id(), err)
+		}
+		delete(ids, key)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceLiteLLMModelGroupCustomizeDiff rejects duplicate deployment keys,
+// which would otherwise map two deployments onto the same model ID.
+func resourceLiteLLMModelGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	seen := make(map[string]bool)
+	for _, raw := range d.Get("deployment").([]interface{}) {
+		dep, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, _ := dep["key"].(string)
+		if key == "" {
+			// Unknown until apply
+			continue
+		}
+		if seen[key] {
+			return fmt.Errorf("deployment key %q is used more than once; keys must be unique within a model group", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// reconcileModelGroup brings the proxy in line with the configured
+// deployments. Deployments are matched to their model IDs by key: new keys are
+// created, changed ones updated and removed ones deleted. deployment_ids is
+// saved even when a call fails, so the deployments already created stay
+// tracked.
+func reconcileModelGroup(d *schema.ResourceData, client *Client, oldDeployments []interface{}, oldIDs map[string]interface{}) error {
+	modelName := d.Get("model_name").(string)
+
+	previous := make(map[string]map[string]interface{})
+	for _, raw := range oldDeployments {
+		dep := raw.(map[string]interface{})
+		previous[dep["key"].(string)] = dep
+	}
+
+	ids := make(map[string]interface{}, len(oldIDs))
+	for key, id := range oldIDs {
+		ids[key] = id
+	}
+
+	configured := make(map[string]bool)
+	err := func() error {
+		for _, raw := range d.Get("deployment").([]interface{}) {
+			dep := raw.(map[string]interface{})
+			key := dep["key"].(string)
+			configured[key] = true
+
+			id, exists := ids[key].(string)
+			if exists && reflect.DeepEqual(previous[key], dep) {
+				continue
+			}
+
+			credentialName := dep["litellm_credential_name"].(string)
+			if credentialName != "" && (!exists || previous[key]["litellm_credential_name"] != credentialName) {
+				if err := checkCredentialExists(client, credentialName); err != nil {
+					return err
+				}
+			}
+
+			if !exists {
+				id = uuid.New().String()
+			}
+			created, err := saveModelGroupDeployment(client, buildModelGroupDeploymentRequest(modelName, dep, id), exists)
+			if err != nil {
+				return fmt.Errorf("failed to save deployment %q of model group %s: %w", key, modelName, err)
+			}
+			if created {
+				log.Printf("[INFO] Created deployment %q of model group %s with ID %s", key, modelName, id)
+			}
+			ids[key] = id
+		}
+
+		for key, id := range ids {
+			if configured[key] {
+				continue
+			}
+			if err := deleteModelGroupDeployment(client, id.(string)); err != nil {
+				return fmt.Errorf("failed to delete deployment %q of model group %s: %w", key, modelName, err)
+			}
+			delete(ids, key)
+		}
+		return nil
+	}()
+
+	d.Set("deployment_ids", ids)
+	return err
+}
+
+// saveModelGroupDeployment sends a deployment to /model/new, or to
+// /model/update when it already exists. A deployment removed outside
+// Terraform is created again. It reports whether the deployment was created.
+func saveModelGroupDeployment(client *Client, modelReq ModelRequest, exists bool) (bool, error) {
+	endpoint := endpointModelNew
+	if exists {
+		endpoint = endpointModelUpdate
+	}
+
+	resp, err := MakeRequest(client, "POST", endpoint, modelReq)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if _, err := handleAPIResponse(resp, modelReq, client); err != nil {
+		if exists && err.Error() == "model_not_found" {
+			return saveModelGroupDeployment(client, modelReq, false)
+		}
+		return false, err
+	}
+	return !exists, nil
+}
+
+// deleteModelGroupDeployment deletes a deployment by model ID. A deployment
+// that no longer exists counts as deleted.
+func deleteModelGroupDeployment(client *Client, modelID string) error {
+	deleteReq := struct {
+		ID string `json:"id"`
+	}{
+		ID: modelID,
+	}
+
+	resp, err := MakeRequest(client, "POST", endpointModelDelete, deleteReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if _, err := handleAPIResponse(resp, deleteReq, client); err != nil && err.Error() != "model_not_found" {
+		return err
+	}
+	return nil
+}
+
+// buildModelGroupDeploymentRequest builds the /model/new and /model/update
+// payload for one deployment of a litellm_model_group.
+func buildModelGroupDeploymentRequest(modelName string, dep map[string]interface{}, modelID string) ModelRequest {
+	customLLMProvider := dep["custom_llm_provider"].(string)
+	baseModel := dep["base_model"].(string)
+
+	litellmParams := map[string]interface{}{
+		"custom_llm_provider": customLLMProvider,
+		"model":               fmt.Sprintf("%s/%s", customLLMProvider, baseModel),
+	}
+	if weight := dep["weight"].(float64); weight > 0 {
+		litellmParams["weight"] = weight
+	}
+	if order := dep["order"].(int); order > 0 {
+		litellmParams["order"] = order
+	}
+	if rpm := dep["rpm"].(int); rpm > 0 {
+		litellmParams["rpm"] = rpm
+	}
+	if tpm := dep["tpm"].(int); tpm > 0 {
+		litellmParams["tpm"] = tpm
+	}
+	if region := dep["region"].(string); region != "" {
+		litellmParams["region_name"] = region
+	}
+	if apiBase := dep["api_base"].(string); apiBase != "" {
+		litellmParams["api_base"] = apiBase
+	}
+	if apiVersion := dep["api_version"].(string); apiVersion != "" {
+		litellmParams["api_version"] = apiVersion
+	}
+	if apiKey := dep["model_api_key"].(string); apiKey != "" {
+		litellmParams["api_key"] = apiKey
+	}
+	if additionalParams, ok := dep["additional_litellm_params"].(map[string]interface{}); ok {
+		addAdditionalLiteLLMParams(litellmParams, additionalParams)
+	}
+	if credentialName := dep["litellm_credential_name"].(string); credentialName != "" {
+		litellmParams["litellm_credential_name"] = credentialName
+	}
+
+	return ModelRequest{
+		ModelName:     modelName,
+		LiteLLMParams: litellmParams,
+		ModelInfo: ModelInfo{
+			ID:        modelID,
+			DBModel:   true,
+			BaseModel: baseModel,
+		},
+		Additional: make(map[string]interface{}),
+	}
+}
+
+// readModelGroup refreshes the deployments from /model/info. When
+// dropMissing is set, deployments no longer on the proxy are removed from
+// state so the next plan creates them again; right after an apply they are
+// kept, since a new deployment may not be listed yet. On import, when no
+// deployment IDs are known, every deployment named model_name is adopted with
+// its model ID as key.
+func readModelGroup(d *schema.ResourceData, client *Client, dropMissing bool) error {
+	models, err := ListModels(client)
+	if err != nil {
+		return err
+	}
+
+	modelName := d.Id()
+	ids := d.Get("deployment_ids").(map[string]interface{})
+	byID := make(map[string]ModelResponse)
+	var imported []interface{}
+	for _, model := range models {
+		if model.ModelName != modelName || model.ModelInfo.ID == "" {
+			continue
+		}
+		byID[model.ModelInfo.ID] = model
+		if len(ids) == 0 {
+			imported = append(imported, map[string]interface{}{"key": model.ModelInfo.ID})
+		}
+	}
+
+	deployments := d.Get("deployment").([]interface{})
+	if len(ids) == 0 {
+		deployments = imported
+		ids = make(map[string]interface{}, len(imported))
+		for _, raw := range imported {
+			key := raw.(map[string]interface{})["key"].(string)
+			ids[key] = key
+		}
+	}
+
+	refreshed := make([]interface{}, 0, len(deployments))
+	refreshedIDs := make(map[string]interface{}, len(ids))
+	for _, raw := range deployments {
+		dep := raw.(map[string]interface{})
+		key := dep["key"].(string)
+		id, _ := ids[key].(string)
+
+		model, found := byID[id]
+		if !found {
+			if dropMissing || id == "" {
+				log.Printf("[INFO] Deployment %q of model group %s not found, removing from state", key, modelName)
+				continue
+			}
+			refreshed = append(refreshed, dep)
+			refreshedIDs[key] = id
+			continue
+		}
+
+		refreshed = append(refreshed, flattenModelGroupDeployment(dep, model))
+		refreshedIDs[key] = id
+	}
+
+	if len(refreshed) == 0 {
+		log.Printf("[INFO] Model group %s has no deployments left, removing from state", modelName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("model_name", modelName)
+	if err := d.Set("deployment", refreshed); err != nil {
+		return fmt.Errorf("error setting deployment: %w", err)
+	}
+	d.Set("deployment_ids", refreshedIDs)
+	return nil
+}
+
+// flattenModelGroupDeployment merges a deployment from /model/info into its
+// state. Secrets and additional_litellm_params are not reliably returned by
+// the proxy and keep their state values.
+func flattenModelGroupDeployment(state map[string]interface{}, model ModelResponse) map[string]interface{} {
+	routedProvider, routedModel := splitModelRoute(model.LiteLLMParams.Model)
+	stateString := func(attr string) string {
+		v, _ := state[attr].(string)
+		return v
+	}
+	stateInt := func(attr string) int {
+		v, _ := state[attr].(int)
+		return v
+	}
+	weight, _ := state["weight"].(float64)
+
+	dep := map[string]interface{}{
+		"key":                     state["key"],
+		"custom_llm_provider":     GetStringValue(model.LiteLLMParams.CustomLLMProvider, GetStringValue(stateString("custom_llm_provider"), routedProvider)),
+		"base_model":              GetStringValue(model.ModelInfo.BaseModel, GetStringValue(stateString("base_model"), routedModel)),
+		"weight":                  GetFloatValue(model.LiteLLMParams.Weight, weight),
+		"order":                   GetIntValue(model.LiteLLMParams.Order, stateInt("order")),
+		"rpm":                     GetIntValue(model.LiteLLMParams.RPM, stateInt("rpm")),
+		"tpm":                     GetIntValue(model.LiteLLMParams.TPM, stateInt("tpm")),
+		"region":                  GetStringValue(model.LiteLLMParams.RegionName, stateString("region")),
+		"api_base":                GetStringValue(model.LiteLLMParams.APIBase, stateString("api_base")),
+		"api_version":             GetStringValue(model.LiteLLMParams.APIVersion, stateString("api_version")),
+		"model_api_key":           stateString("model_api_key"),
+		"litellm_credential_name": GetStringValue(model.LiteLLMParams.LiteLLMCredentialName, stateString("litellm_credential_name")),
+	}
+	if additional, ok := state["additional_litellm_params"]; ok {
+		dep["additional_litellm_params"] = additional
+	}
+	return dep
+}
@@ -0,0 +1,212 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeModelProxy stores models sent to /model/new and /model/update and lists
+// them from /model/info.
+type fakeModelProxy struct {
+	mu     sync.Mutex
+	models map[string]ModelRequest
+	calls  []string
+	srvURL string
+}
+
+func newFakeModelProxy(t *testing.T) *fakeModelProxy {
+	p := &fakeModelProxy{models: make(map[string]ModelRequest)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/model/new", "/model/update":
+			var req ModelRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if _, ok := p.models[req.ModelInfo.ID]; r.URL.Path == "/model/update" && !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"detail": {"error": "Model with id=` + req.ModelInfo.ID + ` not found in db"}}`))
+				return
+			}
+			p.calls = append(p.calls, r.URL.Path+" "+req.ModelInfo.ID)
+			p.models[req.ModelInfo.ID] = req
+			w.Write([]byte(`{}`))
+		case "/model/delete":
+			var req struct {
+				ID string `json:"id"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			p.calls = append(p.calls, r.URL.Path+" "+req.ID)
+			delete(p.models, req.ID)
+			w.Write([]byte(`{}`))
+		case "/model/info":
+			var data []ModelRequest
+			for _, model := range p.models {
+				data = append(data, model)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	p.srvURL = srv.URL
+	return p
+}
+
+func (p *fakeModelProxy) client() *Client {
+	return NewClient(p.srvURL, "test-key", true)
+}
+
+func TestModelGroupCreateSendsEachDeployment(t *testing.T) {
+	proxy := newFakeModelProxy(t)
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModelGroup().Schema, map[string]interface{}{
+		"model_name": "gpt-4o",
+		"deployment": []interface{}{
+			map[string]interface{}{
+				"key":                 "eastus",
+				"custom_llm_provider": "azure",
+				"base_model":          "gpt-4o",
+				"weight":              3.0,
+				"region":              "eastus",
+				"rpm":                 600,
+			},
+			map[string]interface{}{
+				"key":                       "fallback",
+				"custom_llm_provider":       "openai",
+				"base_model":                "gpt-4o",
+				"order":                     2,
+				"additional_litellm_params": map[string]interface{}{"timeout": "30"},
+			},
+		},
+	})
+	if err := resourceLiteLLMModelGroupCreate(d, proxy.client()); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := d.Get("deployment_ids").(map[string]interface{})
+	if d.Id() != "gpt-4o" || len(ids) != 2 || len(proxy.models) != 2 {
+		t.Fatalf("expected two deployments under gpt-4o, got id %q, ids %v, models %v", d.Id(), ids, proxy.models)
+	}
+
+	eastus := proxy.models[ids["eastus"].(string)]
+	if eastus.ModelName != "gpt-4o" || eastus.LiteLLMParams["weight"] != 3.0 || eastus.LiteLLMParams["region_name"] != "eastus" || eastus.LiteLLMParams["model"] != "azure/gpt-4o" {
+		t.Fatalf("unexpected eastus deployment: %+v", eastus)
+	}
+	fallback := proxy.models[ids["fallback"].(string)]
+	if fallback.LiteLLMParams["order"] != 2.0 || fallback.LiteLLMParams["timeout"] != 30.0 {
+		t.Fatalf("unexpected fallback deployment: %+v", fallback)
+	}
+	if got := d.Get("deployment.0.weight").(float64); got != 3 {
+		t.Fatalf("expected weight 3 in state, got %v", got)
+	}
+}
+
+func TestModelGroupReconcileByKey(t *testing.T) {
+	proxy := newFakeModelProxy(t)
+	proxy.models["id-a"] = ModelRequest{ModelName: "gpt-4o", LiteLLMParams: map[string]interface{}{"model": "openai/gpt-4o", "rpm": 10}, ModelInfo: ModelInfo{ID: "id-a"}}
+	proxy.models["id-b"] = ModelRequest{ModelName: "gpt-4o", LiteLLMParams: map[string]interface{}{"model": "openai/gpt-4o"}, ModelInfo: ModelInfo{ID: "id-b"}}
+
+	deployment := func(key string, rpm int) map[string]interface{} {
+		return map[string]interface{}{
+			"key":                       key,
+			"custom_llm_provider":       "openai",
+			"base_model":                "gpt-4o",
+			"weight":                    0.0,
+			"order":                     0,
+			"rpm":                       rpm,
+			"tpm":                       0,
+			"region":                    "",
+			"api_base":                  "",
+			"api_version":               "",
+			"model_api_key":             "",
+			"litellm_credential_name":   "",
+			"additional_litellm_params": map[string]interface{}{},
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModelGroup().Schema, map[string]interface{}{
+		"model_name": "gpt-4o",
+		"deployment": []interface{}{deployment("a", 20), deployment("c", 0)},
+	})
+	d.SetId("gpt-4o")
+	old := []interface{}{deployment("a", 10), deployment("b", 0)}
+	if err := reconcileModelGroup(d, proxy.client(), old, map[string]interface{}{"a": "id-a", "b": "id-b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := d.Get("deployment_ids").(map[string]interface{})
+	if ids["a"] != "id-a" || ids["b"] != nil || ids["c"] == nil {
+		t.Fatalf("unexpected deployment_ids: %v", ids)
+	}
+	calls := strings.Join(proxy.calls, "\n")
+	for _, want := range []string{"/model/update id-a", "/model/delete id-b", "/model/new " + ids["c"].(string)} {
+		if !strings.Contains(calls, want) {
+			t.Fatalf("expected call %q, got:\n%s", want, calls)
+		}
+	}
+	if got := proxy.models["id-a"].LiteLLMParams["rpm"]; got != 20.0 {
+		t.Fatalf("expected rpm 20 on id-a, got %v", got)
+	}
+}
+
+func TestModelGroupReadDropsMissingAndImports(t *testing.T) {
+	proxy := newFakeModelProxy(t)
+	proxy.models["id-a"] = ModelRequest{ModelName: "gpt-4o", LiteLLMParams: map[string]interface{}{"model": "openai/gpt-4o", "weight": 2}, ModelInfo: ModelInfo{ID: "id-a"}}
+	proxy.models["id-x"] = ModelRequest{ModelName: "claude", LiteLLMParams: map[string]interface{}{"model": "anthropic/claude"}, ModelInfo: ModelInfo{ID: "id-x"}}
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMModelGroup().Schema, map[string]interface{}{
+		"model_name": "gpt-4o",
+		"deployment": []interface{}{
+			map[string]interface{}{"key": "a", "custom_llm_provider": "openai", "base_model": "gpt-4o", "model_api_key": "sk-a"},
+			map[string]interface{}{"key": "b", "custom_llm_provider": "openai", "base_model": "gpt-4o"},
+		},
+	})
+	d.SetId("gpt-4o")
+	d.Set("deployment_ids", map[string]interface{}{"a": "id-a", "b": "id-b"})
+	if err := resourceLiteLLMModelGroupRead(d, proxy.client()); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("deployment").([]interface{}); len(got) != 1 {
+		t.Fatalf("expected the missing deployment to be dropped, got %v", got)
+	}
+	if d.Get("deployment.0.weight").(float64) != 2 || d.Get("deployment.0.model_api_key").(string) != "sk-a" {
+		t.Fatalf("unexpected refreshed deployment: %v", d.Get("deployment.0"))
+	}
+	if ids := d.Get("deployment_ids").(map[string]interface{}); len(ids) != 1 || ids["a"] != "id-a" {
+		t.Fatalf("unexpected deployment_ids: %v", ids)
+	}
+
+	imported := resourceLiteLLMModelGroup().Data(nil)
+	imported.SetId("gpt-4o")
+	if err := resourceLiteLLMModelGroupRead(imported, proxy.client()); err != nil {
+		t.Fatal(err)
+	}
+	if imported.Get("model_name") != "gpt-4o" || imported.Get("deployment.0.key") != "id-a" || imported.Get("deployment.0.custom_llm_provider") != "openai" || imported.Get("deployment_ids.id-a") != "id-a" {
+		t.Fatalf("unexpected imported state: %v", imported.State())
+	}
+}
+
+func TestModelGroupRejectsDuplicateKeys(t *testing.T) {
+	_, err := resourceLiteLLMModelGroup().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"model_name": "gpt-4o",
+		"deployment": []interface{}{
+			map[string]interface{}{"key": "a", "custom_llm_provider": "openai", "base_model": "gpt-4o"},
+			map[string]interface{}{"key": "a", "custom_llm_provider": "azure", "base_model": "gpt-4o"},
+		},
+	}), nil)
+	if err == nil || !strings.Contains(err.Error(), `deployment key "a" is used more than once`) {
+		t.Fatalf("expected a duplicate key error, got %v", err)
+	}
+}
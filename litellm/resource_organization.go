@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ const (
 	endpointOrganizationInfo   = "/organization/info"
 	endpointOrganizationUpdate = "/organization/update"
 	endpointOrganizationDelete = "/organization/delete"
+	endpointOrganizationList   = "/organization/list"
 )
 
 func resourceLiteLLMOrganization() *schema.Resource {
@@ -23,6 +25,9 @@ func resourceLiteLLMOrganization() *schema.Resource {
 		Read:   resourceLiteLLMOrganizationRead,
 		Update: resourceLiteLLMOrganizationUpdate,
 		Delete: resourceLiteLLMOrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMOrganizationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"organization_alias": {
@@ -59,6 +64,24 @@ func resourceLiteLLMOrganization() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"soft_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Budget at which budget alerts are sent, without blocking requests",
+			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of guardrail names applied to all requests made within the organization",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of tags used for tag-based routing and spend tracking",
+			},
+			"object_permission": objectPermissionSchema(),
 		},
 	}
 }
@@ -87,42 +110,112 @@ func resourceLiteLLMOrganizationCreate(d *schema.ResourceData, m interface{}) er
 	return resourceLiteLLMOrganizationRead(d, m)
 }
 
-func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+// resourceLiteLLMOrganizationImport imports an organization by ID, or by alias
+// when the import ID has the form "organization_alias=<alias>".
+func resourceLiteLLMOrganizationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if orgAlias, ok := parseImportAlias(d.Id(), "organization_alias"); ok {
+		orgID, err := findOrganizationIDByAlias(m.(*Client), orgAlias)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(orgID)
+	}
 
-	log.Printf("[INFO] Reading organization with ID: %s", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
 
+// findOrganizationIDByAlias looks up the ID of the organization with the given alias.
+func findOrganizationIDByAlias(client *Client, orgAlias string) (string, error) {
+	orgs, err := ListOrganizations(client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, org := range orgs {
+		if org.OrganizationAlias == orgAlias {
+			ids = append(ids, org.OrganizationID)
+		}
+	}
+	return resolveImportAlias("organization", "organization_alias", orgAlias, ids)
+}
+
+// getOrganizationInfo fetches an organization from /organization/info. It
+// returns nil without an error when the organization does not exist.
+func getOrganizationInfo(client *Client, orgID string) (*OrganizationResponse, error) {
 	resp, err := MakeRequest(client, "POST", endpointOrganizationInfo, map[string]interface{}{
-		"organizations": []string{d.Id()},
+		"organizations": []string{orgID},
 	})
 	if err != nil {
-		return fmt.Errorf("error reading organization: %w", err)
+		return nil, fmt.Errorf("error reading organization: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		log.Printf("[WARN] Organization with ID %s not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+		return nil, nil
+	}
+	if err := handleResponse(resp, "reading organization"); err != nil {
+		return nil, err
 	}
 
 	var orgResps []OrganizationResponse
 	if err := json.NewDecoder(resp.Body).Decode(&orgResps); err != nil {
-		return fmt.Errorf("error decoding organization info response: %w", err)
+		return nil, fmt.Errorf("error decoding organization info response: %w", err)
 	}
 
 	if len(orgResps) == 0 {
-		log.Printf("[WARN] Organization with ID %s not found in response, removing from state", d.Id())
+		return nil, nil
+	}
+
+	return &orgResps[0], nil
+}
+
+// applyOrganizationBudgetTable fills the organization's budget and rate
+// limits from its budget table, where the proxy keeps them. Top-level fields
+// are preferred when the proxy returns them.
+func applyOrganizationBudgetTable(orgResp *OrganizationResponse) {
+	budget := orgResp.LiteLLMBudgetTable
+	if budget == nil {
+		return
+	}
+	if orgResp.MaxBudget == nil {
+		orgResp.MaxBudget = budget.MaxBudget
+	}
+	if orgResp.SoftBudget == nil {
+		orgResp.SoftBudget = budget.SoftBudget
+	}
+	if orgResp.TPMLimit == nil {
+		orgResp.TPMLimit = budget.TPMLimit
+	}
+	if orgResp.RPMLimit == nil {
+		orgResp.RPMLimit = budget.RPMLimit
+	}
+	if orgResp.BudgetDuration == "" {
+		orgResp.BudgetDuration = budget.BudgetDuration
+	}
+}
+
+func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	log.Printf("[INFO] Reading organization with ID: %s", d.Id())
+
+	orgResp, err := getOrganizationInfo(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if orgResp == nil {
+		log.Printf("[WARN] Organization with ID %s not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	orgResp := orgResps[0]
+	applyOrganizationBudgetTable(orgResp)
 
 	d.Set("organization_alias", GetStringValue(orgResp.OrganizationAlias, d.Get("organization_alias").(string)))
 
 	if orgResp.Metadata != nil {
-		d.Set("metadata", orgResp.Metadata)
+		d.Set("metadata", stripReservedMetadata(orgResp.Metadata, organizationReservedMetadataKeys...))
 	} else {
 		d.Set("metadata", d.Get("metadata"))
 	}
@@ -144,6 +237,29 @@ func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) erro
 		d.Set("rpm_limit", *orgResp.RPMLimit)
 	}
 	d.Set("blocked", GetBoolValue(orgResp.Blocked, d.Get("blocked").(bool)))
+	if orgResp.SoftBudget != nil {
+		d.Set("soft_budget", *orgResp.SoftBudget)
+	}
+
+	// The proxy persists guardrails and tags in the organization metadata, so
+	// fall back to it when they are not returned as top-level fields
+	guardrails := orgResp.Guardrails
+	if guardrails == nil {
+		guardrails = metadataStringList(orgResp.Metadata, "guardrails")
+	}
+	if guardrails == nil {
+		guardrails = []string{}
+	}
+	d.Set("guardrails", guardrails)
+	tags := orgResp.Tags
+	if tags == nil {
+		tags = metadataStringList(orgResp.Metadata, "tags")
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	d.Set("tags", tags)
+	d.Set("object_permission", flattenObjectPermission(orgResp.ObjectPermission))
 
 	log.Printf("[INFO] Successfully read organization with ID: %s", d.Id())
 	return nil
@@ -194,17 +310,32 @@ func resourceLiteLLMOrganizationDelete(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
+// organizationReservedMetadataKeys are metadata keys the proxy uses to store
+// organization attributes that the provider manages as first-class arguments.
+var organizationReservedMetadataKeys = []string{"guardrails", "tags"}
+
 func buildOrganizationData(d *schema.ResourceData, orgID string) map[string]interface{} {
 	orgData := map[string]interface{}{
 		"organization_id":    orgID,
 		"organization_alias": d.Get("organization_alias").(string),
 	}
 
-	for _, key := range []string{"metadata", "models", "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "blocked"} {
+	for _, key := range []string{"metadata", "models", "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "blocked", "soft_budget", "guardrails", "tags"} {
 		if v, ok := d.GetOk(key); ok {
 			orgData[key] = v
 		}
 	}
 
+	// Lists emptied in configuration are sent explicitly so the proxy clears them
+	for _, key := range []string{"guardrails", "tags"} {
+		if _, ok := orgData[key]; !ok && d.HasChange(key) {
+			orgData[key] = []interface{}{}
+		}
+	}
+
+	if objectPermission := expandObjectPermission(d); objectPermission != nil {
+		orgData["object_permission"] = objectPermission
+	}
+
 	return orgData
 }
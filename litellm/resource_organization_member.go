@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,15 +15,20 @@ func resourceLiteLLMOrganizationMember() *schema.Resource {
 		Read:   resourceLiteLLMOrganizationMemberRead,
 		Update: resourceLiteLLMOrganizationMemberUpdate,
 		Delete: resourceLiteLLMOrganizationMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMOrganizationMemberImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"user_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"user_email": {
 				Type:     schema.TypeString,
@@ -73,12 +79,61 @@ func resourceLiteLLMOrganizationMemberCreate(d *schema.ResourceData, m interface
 }
 
 func resourceLiteLLMOrganizationMemberRead(d *schema.ResourceData, m interface{}) error {
-	// There's no specific endpoint to read a single organization member
-	// We'll just return the data we have in the state
+	client := m.(*Client)
+
 	log.Printf("[INFO] Reading organization member with ID: %s", d.Id())
+
+	orgID, userID, err := parseMemberID(d.Id(), "organization_id")
+	if err != nil {
+		return err
+	}
+
+	// There's no endpoint to read a single organization member, so find it in the organization
+	orgResp, err := getOrganizationInfo(client, orgID)
+	if err != nil {
+		return err
+	}
+	if orgResp == nil {
+		log.Printf("[WARN] Organization with ID %s not found, removing organization member from state", orgID)
+		d.SetId("")
+		return nil
+	}
+
+	var member *OrganizationMember
+	for i := range orgResp.Members {
+		if orgResp.Members[i].UserID == userID {
+			member = &orgResp.Members[i]
+			break
+		}
+	}
+	if member == nil {
+		log.Printf("[WARN] User %s is no longer a member of organization %s, removing from state", userID, orgID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("organization_id", orgID)
+	d.Set("user_id", userID)
+	d.Set("role", member.UserRole)
+	if member.User != nil {
+		d.Set("user_email", GetStringValue(member.User.UserEmail, d.Get("user_email").(string)))
+	}
+
 	return nil
 }
 
+func resourceLiteLLMOrganizationMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	orgID, userID, err := parseMemberID(d.Id(), "organization_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("organization_id", orgID)
+	d.Set("user_id", userID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceLiteLLMOrganizationMemberUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
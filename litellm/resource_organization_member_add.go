@@ -93,8 +93,43 @@ func resourceLiteLLMOrganizationMemberAddCreate(d *schema.ResourceData, m interf
 }
 
 func resourceLiteLLMOrganizationMemberAddRead(d *schema.ResourceData, m interface{}) error {
-	// The API doesn't provide a way to read specific organization members easily
-	// We'll maintain the state as is
+	client := m.(*Client)
+	orgID := d.Get("organization_id").(string)
+	if orgID == "" {
+		orgID = d.Id()
+	}
+
+	log.Printf("[INFO] Reading members of organization with ID: %s", orgID)
+
+	orgResp, err := getOrganizationInfo(client, orgID)
+	if err != nil {
+		return err
+	}
+	if orgResp == nil {
+		log.Printf("[WARN] Organization with ID %s not found, removing organization members from state", orgID)
+		d.SetId("")
+		return nil
+	}
+
+	serverMembers := make([]map[string]interface{}, 0, len(orgResp.Members))
+	for _, member := range orgResp.Members {
+		userEmail := ""
+		if member.User != nil {
+			userEmail = member.User.UserEmail
+		}
+		serverMembers = append(serverMembers, map[string]interface{}{
+			"user_id":    member.UserID,
+			"user_email": userEmail,
+			"role":       member.UserRole,
+		})
+	}
+
+	d.Set("organization_id", orgID)
+	members := reconcileMemberSet(d.Get("member").(*schema.Set).List(), serverMembers)
+	if err := d.Set("member", members); err != nil {
+		return fmt.Errorf("error setting organization members: %w", err)
+	}
+
 	return nil
 }
 
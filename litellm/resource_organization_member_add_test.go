@@ -2,9 +2,12 @@ package litellm
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -72,3 +75,56 @@ resource "litellm_organization_member_add" "test_members" {
 }
 `, orgAlias, user1, user1, user2, user2)
 }
+
+func TestOrganizationMemberAddReadDetectsDrift(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/organization/info" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"organization_id": "org-1",
+			"members": [
+				{"user_id": "alice", "user_role": "internal_user_viewer", "user": {"user_email": "alice@example.com"}},
+				{"user_id": "u-bob", "user_role": "org_admin", "user": {"user_email": "bob@example.com"}}
+			]
+		}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMOrganizationMemberAdd().Schema, map[string]interface{}{
+		"organization_id": "org-1",
+		"member": []interface{}{
+			map[string]interface{}{"user_id": "alice", "role": "internal_user"},
+			map[string]interface{}{"user_email": "BOB@example.com", "role": "org_admin"},
+			map[string]interface{}{"user_id": "carol", "role": "internal_user"},
+		},
+	})
+	d.SetId("org-1")
+
+	if err := resourceLiteLLMOrganizationMemberAddRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	members := d.Get("member").(*schema.Set).List()
+	if len(members) != 2 {
+		t.Fatalf("expected removed member to be dropped, got %v", members)
+	}
+	for _, raw := range members {
+		member := raw.(map[string]interface{})
+		switch {
+		case member["user_id"] == "alice":
+			if member["role"] != "internal_user_viewer" {
+				t.Fatalf("expected role change to be read back, got %v", member)
+			}
+		case member["user_email"] == "BOB@example.com":
+			if member["role"] != "org_admin" {
+				t.Fatalf("unexpected role for email-matched member: %v", member)
+			}
+		default:
+			t.Fatalf("unexpected member in state: %v", member)
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -64,3 +68,45 @@ resource "litellm_organization_member" "test_member" {
 }
 `, orgAlias, userID, userID)
 }
+
+func TestOrganizationMemberImportAndRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"organization_id": "org-1",
+			"members": [
+				{"user_id": "user-1", "user_role": "internal_user_viewer", "user": {"user_email": "user@example.com"}}
+			]
+		}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMOrganizationMember().Schema, map[string]interface{}{})
+	d.SetId("org-1:user-1")
+
+	if _, err := resourceLiteLLMOrganizationMemberImport(context.Background(), d, client); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if err := resourceLiteLLMOrganizationMemberRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if d.Get("organization_id").(string) != "org-1" || d.Get("user_id").(string) != "user-1" {
+		t.Fatalf("identity not populated from ID: organization_id=%q user_id=%q", d.Get("organization_id"), d.Get("user_id"))
+	}
+	if d.Get("role").(string) != "internal_user_viewer" {
+		t.Fatalf("expected role internal_user_viewer, got %q", d.Get("role"))
+	}
+	if d.Get("user_email").(string) != "user@example.com" {
+		t.Fatalf("expected user_email to be read back, got %q", d.Get("user_email"))
+	}
+
+	d.SetId("org-1:user-2")
+	if err := resourceLiteLLMOrganizationMemberRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected missing membership to be removed from state, got ID %q", d.Id())
+	}
+}
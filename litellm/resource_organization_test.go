@@ -2,9 +2,12 @@ package litellm
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -57,3 +60,43 @@ resource "litellm_organization" "test" {
 }
 `, alias)
 }
+
+func TestOrganizationReadUsesBudgetTableAndMetadata(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"organization_id": "org-1",
+			"organization_alias": "acme",
+			"metadata": {"tags": ["prod"], "guardrails": ["pii-masking"]},
+			"models": [],
+			"litellm_budget_table": {"max_budget": 100, "soft_budget": 80, "tpm_limit": 5000}
+		}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMOrganization().Schema, map[string]interface{}{
+		"organization_alias": "acme",
+	})
+	d.SetId("org-1")
+
+	if err := resourceLiteLLMOrganizationRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if got := d.Get("soft_budget").(float64); got != 80 {
+		t.Fatalf("soft_budget not read from budget table: %v", got)
+	}
+	if got := d.Get("max_budget").(float64); got != 100 {
+		t.Fatalf("max_budget not read from budget table: %v", got)
+	}
+	if got := d.Get("tags").([]interface{}); len(got) != 1 || got[0] != "prod" {
+		t.Fatalf("tags not read back: %v", got)
+	}
+	if got := d.Get("guardrails").([]interface{}); len(got) != 1 || got[0] != "pii-masking" {
+		t.Fatalf("guardrails not read back: %v", got)
+	}
+	if got := d.Get("metadata").(map[string]interface{}); len(got) != 0 {
+		t.Fatalf("reserved keys not stripped from metadata: %v", got)
+	}
+}
@@ -0,0 +1,88 @@
+package litellm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// routerFallbackAttrs are the router settings holding fallback chains, in the
+// {"<model>": ["<fallback>", ...]} list format the proxy uses.
+var routerFallbackAttrs = []string{"fallbacks", "context_window_fallbacks", "content_policy_fallbacks"}
+
+// routerScalarAttrs are the scalar router settings managed by
+// litellm_router_settings.
+var routerScalarAttrs = []string{"routing_strategy", "num_retries", "allowed_fails", "cooldown_time"}
+
+func resourceLiteLLMRouterSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiteLLMRouterSettingsCreate,
+		Read:   resourceLiteLLMRouterSettingsRead,
+		Update: resourceLiteLLMRouterSettingsUpdate,
+		Delete: resourceLiteLLMRouterSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMRouterSettingsImport,
+		},
+		CustomizeDiff: resourceLiteLLMRouterSettingsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"routing_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"simple-shuffle",
+					"least-busy",
+					"usage-based-routing",
+					"usage-based-routing-v2",
+					"latency-based-routing",
+					"cost-based-routing",
+				}, false),
+				Description: "Strategy the router uses to pick a deployment within a model group",
+			},
+			"num_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a failed request is retried",
+			},
+			"allowed_fails": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of failures per minute before a deployment is cooled down",
+			},
+			"cooldown_time": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Seconds a deployment is cooled down after exceeding allowed_fails",
+			},
+			"fallbacks":                routerFallbackSchema("Models to try, in order, when a request to model fails"),
+			"context_window_fallbacks": routerFallbackSchema("Models to try when a request to model exceeds its context window"),
+			"content_policy_fallbacks": routerFallbackSchema("Models to try when a request to model is rejected by a content policy"),
+		},
+	}
+}
+
+func routerFallbackSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"model": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Model name the fallback chain applies to",
+				},
+				"fallback_models": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Model names to fall back to, in order",
+				},
+			},
+		},
+	}
+}
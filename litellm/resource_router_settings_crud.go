@@ -0,0 +1,275 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointConfigUpdate    = "/config/update"
+	endpointConfigCallbacks = "/get/config/callbacks"
+)
+
+// routerSettingsID is the ID of the litellm_router_settings singleton.
+const routerSettingsID = "router_settings"
+
+func resourceLiteLLMRouterSettingsCreate(d *schema.ResourceData, m interface{}) error {
+	if err := updateRouterSettings(d, m.(*Client), false); err != nil {
+		return err
+	}
+
+	d.SetId(routerSettingsID)
+	return resourceLiteLLMRouterSettingsRead(d, m)
+}
+
+func resourceLiteLLMRouterSettingsRead(d *schema.ResourceData, m interface{}) error {
+	return readRouterSettings(d, m.(*Client), false)
+}
+
+func resourceLiteLLMRouterSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := updateRouterSettings(d, m.(*Client), true); err != nil {
+		return err
+	}
+
+	return resourceLiteLLMRouterSettingsRead(d, m)
+}
+
+// resourceLiteLLMRouterSettingsDelete clears the fallback chains managed by
+// the resource. The scalar settings have no "unset" on the proxy and keep
+// their last value.
+func resourceLiteLLMRouterSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	settings := make(map[string]interface{})
+	for _, attr := range routerFallbackAttrs {
+		if len(d.Get(attr).([]interface{})) > 0 {
+			settings[attr] = []interface{}{}
+		}
+	}
+
+	if len(settings) > 0 {
+		if err := postRouterSettings(m.(*Client), settings); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceLiteLLMRouterSettingsImport adopts every managed setting the proxy
+// currently has. The import ID is ignored.
+func resourceLiteLLMRouterSettingsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(routerSettingsID)
+	if err := readRouterSettings(d, m.(*Client), true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceLiteLLMRouterSettingsCustomizeDiff checks that every fallback
+// target is a model name known to the proxy. Targets not known until apply,
+// such as ones referencing a model created in the same run, are checked by
+// Create and Update instead.
+func resourceLiteLLMRouterSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok || client == nil || !d.HasChanges(routerFallbackAttrs...) {
+		return nil
+	}
+
+	targets := make(map[string][]interface{})
+	for _, attr := range routerFallbackAttrs {
+		targets[attr] = d.Get(attr).([]interface{})
+	}
+	return checkRouterFallbackTargets(client, targets)
+}
+
+// checkRouterFallbackTargets returns an error naming every fallback target
+// that no model deployment on the proxy uses as its model_name. Empty
+// (unknown) targets are skipped.
+func checkRouterFallbackTargets(client *Client, fallbacks map[string][]interface{}) error {
+	type fallbackTarget struct {
+		attr, model, target string
+	}
+
+	var targets []fallbackTarget
+	for _, attr := range routerFallbackAttrs {
+		for _, raw := range fallbacks[attr] {
+			chain, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			model, _ := chain["model"].(string)
+			for _, target := range chain["fallback_models"].([]interface{}) {
+				if name, _ := target.(string); name != "" {
+					targets = append(targets, fallbackTarget{attr: attr, model: model, target: name})
+				}
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	models, err := ListModels(client)
+	if err != nil {
+		return fmt.Errorf("failed to check fallback targets: %w", err)
+	}
+	known := make(map[string]bool)
+	for _, model := range models {
+		known[model.ModelName] = true
+	}
+
+	var missing []string
+	for _, t := range targets {
+		if !known[t.target] {
+			missing = append(missing, fmt.Sprintf("%s: %q falls back to %q", t.attr, t.model, t.target))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("fallback targets do not match any litellm_model model_name on the proxy:\n  %s", strings.Join(missing, "\n  "))
+	}
+	return nil
+}
+
+// updateRouterSettings sends the configured settings to /config/update, which
+// merges them into the stored router_settings. Settings not set in
+// configuration are left untouched; a fallback list removed from
+// configuration is cleared.
+func updateRouterSettings(d *schema.ResourceData, client *Client, isUpdate bool) error {
+	fallbacks := make(map[string][]interface{})
+	for _, attr := range routerFallbackAttrs {
+		fallbacks[attr] = d.Get(attr).([]interface{})
+	}
+	if err := checkRouterFallbackTargets(client, fallbacks); err != nil {
+		return err
+	}
+
+	settings := make(map[string]interface{})
+	for _, attr := range routerScalarAttrs {
+		// GetOkExists keeps explicit zeros such as num_retries = 0.
+		if v, ok := d.GetOkExists(attr); ok {
+			settings[attr] = v
+		}
+	}
+	for _, attr := range routerFallbackAttrs {
+		if len(fallbacks[attr]) > 0 || (isUpdate && d.HasChange(attr)) {
+			settings[attr] = expandRouterFallbacks(fallbacks[attr])
+		}
+	}
+	if len(settings) == 0 {
+		return nil
+	}
+
+	return postRouterSettings(client, settings)
+}
+
+func postRouterSettings(client *Client, settings map[string]interface{}) error {
+	log.Printf("[INFO] Updating router settings: %v", settings)
+
+	resp, err := MakeRequest(client, "POST", endpointConfigUpdate, ConfigUpdateRequest{RouterSettings: settings})
+	if err != nil {
+		return fmt.Errorf("error updating router settings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return handleResponse(resp, "updating router settings")
+}
+
+// readRouterSettings refreshes the managed settings from the router settings
+// reported by /get/config/callbacks. Only settings with a value in state are
+// refreshed, unless all is set (on import). A managed setting the proxy no
+// longer reports is cleared from state.
+func readRouterSettings(d *schema.ResourceData, client *Client, all bool) error {
+	resp, err := MakeRequest(client, "GET", endpointConfigCallbacks, nil)
+	if err != nil {
+		return fmt.Errorf("error reading router settings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "reading router settings"); err != nil {
+		return err
+	}
+
+	var config ConfigCallbacksResponse
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return fmt.Errorf("error decoding router settings response: %w", err)
+	}
+
+	for _, attr := range routerScalarAttrs {
+		_, inState := d.GetOkExists(attr)
+		value := config.RouterSettings[attr]
+		if value == nil {
+			if inState {
+				d.Set(attr, nil)
+			}
+			continue
+		}
+		if !all && !inState {
+			continue
+		}
+		if v, ok := value.(float64); ok && attr != "cooldown_time" {
+			value = int(v)
+		}
+		d.Set(attr, value)
+	}
+	for _, attr := range routerFallbackAttrs {
+		_, inState := d.GetOk(attr)
+		if config.RouterSettings[attr] == nil && !inState {
+			continue
+		}
+		if !all && !inState {
+			continue
+		}
+		if err := d.Set(attr, flattenRouterFallbacks(config.RouterSettings[attr])); err != nil {
+			return fmt.Errorf("error setting %s: %w", attr, err)
+		}
+	}
+
+	return nil
+}
+
+// expandRouterFallbacks converts fallback blocks into the proxy's
+// [{"<model>": ["<fallback>", ...]}] format.
+func expandRouterFallbacks(chains []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(chains))
+	for _, raw := range chains {
+		chain := raw.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			chain["model"].(string): chain["fallback_models"],
+		})
+	}
+	return result
+}
+
+// flattenRouterFallbacks converts the proxy's fallback list into fallback
+// blocks. An entry mapping several models is split into one block per model,
+// sorted by model name.
+func flattenRouterFallbacks(raw interface{}) []interface{} {
+	entries, _ := raw.([]interface{})
+	chains := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		byModel, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		models := make([]string, 0, len(byModel))
+		for model := range byModel {
+			models = append(models, model)
+		}
+		sort.Strings(models)
+		for _, model := range models {
+			targets, _ := byModel[model].([]interface{})
+			chains = append(chains, map[string]interface{}{
+				"model":           model,
+				"fallback_models": targets,
+			})
+		}
+	}
+	return chains
+}
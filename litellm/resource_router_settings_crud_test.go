@@ -0,0 +1,137 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newRouterSettingsServer serves two models and stores router settings sent
+// to /config/update, merging them like the proxy does.
+func newRouterSettingsServer(t *testing.T, stored map[string]interface{}, updates *[]map[string]interface{}) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/model/info":
+			w.Write([]byte(`{"data": [{"model_name": "gpt-4o", "model_info": {"id": "1"}}, {"model_name": "claude-sonnet", "model_info": {"id": "2"}}]}`))
+		case "/config/update":
+			var req struct {
+				RouterSettings map[string]interface{} `json:"router_settings"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			*updates = append(*updates, req.RouterSettings)
+			for k, v := range req.RouterSettings {
+				stored[k] = v
+			}
+			w.Write([]byte(`{"message": "Config updated successfully"}`))
+		case "/get/config/callbacks":
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "callbacks": []interface{}{}, "router_settings": stored})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRouterSettingsFallbackTargetsCheckedAtPlan(t *testing.T) {
+	var updates []map[string]interface{}
+	srv := newRouterSettingsServer(t, map[string]interface{}{}, &updates)
+
+	_, err := resourceLiteLLMRouterSettings().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"fallbacks": []interface{}{
+			map[string]interface{}{"model": "gpt-4o", "fallback_models": []interface{}{"claude-sonnet", "gpt-4o-mnii"}},
+		},
+		"context_window_fallbacks": []interface{}{
+			map[string]interface{}{"model": "gpt-4o", "fallback_models": []interface{}{"claude-sonnet"}},
+		},
+	}), NewClient(srv.URL, "test-key", true))
+	if err == nil || !strings.Contains(err.Error(), `fallbacks: "gpt-4o" falls back to "gpt-4o-mnii"`) || strings.Contains(err.Error(), "context_window_fallbacks") {
+		t.Fatalf("expected only the misspelled target to be reported, got %v", err)
+	}
+}
+
+func TestRouterSettingsManagesDeclaredKeysOnly(t *testing.T) {
+	stored := map[string]interface{}{"routing_strategy": "least-busy", "num_retries": 2, "timeout": 30}
+	var updates []map[string]interface{}
+	srv := newRouterSettingsServer(t, stored, &updates)
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMRouterSettings().Schema, map[string]interface{}{
+		"num_retries": 3,
+		"fallbacks": []interface{}{
+			map[string]interface{}{"model": "gpt-4o", "fallback_models": []interface{}{"claude-sonnet"}},
+		},
+	})
+	if err := resourceLiteLLMRouterSettingsCreate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"num_retries": 3.0,
+		"fallbacks":   []interface{}{map[string]interface{}{"gpt-4o": []interface{}{"claude-sonnet"}}},
+	}
+	if len(updates) != 1 || !reflect.DeepEqual(updates[0], want) {
+		t.Fatalf("expected only the declared settings to be sent, got %v", updates)
+	}
+	if d.Id() != "router_settings" || d.Get("routing_strategy").(string) != "" || d.Get("fallbacks.0.fallback_models.0").(string) != "claude-sonnet" {
+		t.Fatalf("unexpected state: %v", d.State())
+	}
+
+	imported := resourceLiteLLMRouterSettings().Data(nil)
+	imported.SetId("anything")
+	if _, err := resourceLiteLLMRouterSettingsImport(context.Background(), imported, client); err != nil {
+		t.Fatal(err)
+	}
+	if imported.Id() != "router_settings" || imported.Get("routing_strategy").(string) != "least-busy" || imported.Get("num_retries").(int) != 3 || imported.Get("fallbacks.0.model").(string) != "gpt-4o" {
+		t.Fatalf("unexpected imported state: %v", imported.State())
+	}
+
+	if err := resourceLiteLLMRouterSettingsDelete(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if got := updates[len(updates)-1]; !reflect.DeepEqual(got, map[string]interface{}{"fallbacks": []interface{}{}}) {
+		t.Fatalf("expected delete to clear only fallbacks, got %v", got)
+	}
+}
+
+func TestRouterSettingsZeroValuesSentAndMissingSettingsCleared(t *testing.T) {
+	stored := map[string]interface{}{"num_retries": 2}
+	var updates []map[string]interface{}
+	srv := newRouterSettingsServer(t, stored, &updates)
+	client := NewClient(srv.URL, "test-key", true)
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMRouterSettings().Schema, map[string]interface{}{
+		"routing_strategy": "least-busy",
+		"num_retries":      0,
+		"allowed_fails":    3,
+		"cooldown_time":    0.0,
+	})
+	if err := resourceLiteLLMRouterSettingsCreate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"routing_strategy": "least-busy", "num_retries": 0.0, "allowed_fails": 3.0, "cooldown_time": 0.0}
+	if len(updates) != 1 || !reflect.DeepEqual(updates[0], want) {
+		t.Fatalf("expected the zero settings to be sent, got %v", updates)
+	}
+	if stored["num_retries"] != 0.0 {
+		t.Fatalf("num_retries = 0 not stored on the proxy: %v", stored)
+	}
+
+	delete(stored, "routing_strategy")
+	delete(stored, "allowed_fails")
+	if err := resourceLiteLLMRouterSettingsRead(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("routing_strategy").(string) != "" || d.Get("allowed_fails").(int) != 0 {
+		t.Fatalf("expected settings missing on the proxy to be cleared, got %v", d.State().Attributes)
+	}
+}
@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,6 +17,9 @@ const (
 	endpointTeamInfo              = "/team/info"
 	endpointTeamUpdate            = "/team/update"
 	endpointTeamDelete            = "/team/delete"
+	endpointTeamList              = "/team/list"
+	endpointTeamBlock             = "/team/block"
+	endpointTeamUnblock           = "/team/unblock"
 	endpointTeamPermissionsList   = "/team/permissions_list"
 	endpointTeamPermissionsUpdate = "/team/permissions_update"
 )
@@ -26,6 +30,9 @@ func ResourceLiteLLMTeam() *schema.Resource {
 		Read:   resourceLiteLLMTeamRead,
 		Update: resourceLiteLLMTeamUpdate,
 		Delete: resourceLiteLLMTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMTeamImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"team_alias": {
@@ -72,6 +79,45 @@ func ResourceLiteLLMTeam() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of permissions granted to team members",
 			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of guardrail names applied to all requests made by the team",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of tags used for tag-based routing and spend tracking",
+			},
+			"model_aliases": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of model alias to the model name it resolves to for this team",
+			},
+			"soft_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Budget at which budget alerts are sent, without blocking requests",
+			},
+			"team_member_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum budget for each individual team member",
+			},
+			"team_member_rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit for each individual team member",
+			},
+			"team_member_tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit for each individual team member",
+			},
+			"object_permission": objectPermissionSchema(),
 		},
 	}
 }
@@ -81,6 +127,11 @@ func resourceLiteLLMTeamCreate(d *schema.ResourceData, m interface{}) error {
 
 	teamID := uuid.New().String()
 	teamData := buildTeamData(d, teamID)
+	// A new team has no cached state to invalidate, so it can be created
+	// blocked directly
+	if d.Get("blocked").(bool) {
+		teamData["blocked"] = true
+	}
 
 	log.Printf("[DEBUG] Create team request payload: %+v", teamData)
 
@@ -105,30 +156,23 @@ func resourceLiteLLMTeamRead(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("[INFO] Reading team with ID: %s", d.Id())
 
-	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?team_id=%s", endpointTeamInfo, d.Id()), nil)
+	teamResp, err := getTeamInfo(client, d.Id())
 	if err != nil {
-		return fmt.Errorf("error reading team: %w", err)
+		return err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
+	if teamResp == nil {
 		log.Printf("[WARN] Team with ID %s not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	var teamResp TeamResponse
-	if err := json.NewDecoder(resp.Body).Decode(&teamResp); err != nil {
-		return fmt.Errorf("error decoding team info response: %w", err)
-	}
-
 	// Update the state with values from the response or fall back to the data passed in during creation
 	d.Set("team_alias", GetStringValue(teamResp.TeamAlias, d.Get("team_alias").(string)))
 	d.Set("organization_id", GetStringValue(teamResp.OrganizationID, d.Get("organization_id").(string)))
 
 	// Handle metadata separately as it's a map
 	if teamResp.Metadata != nil {
-		d.Set("metadata", teamResp.Metadata)
+		d.Set("metadata", stripReservedMetadata(teamResp.Metadata, teamReservedMetadataKeys...))
 	} else {
 		d.Set("metadata", d.Get("metadata"))
 	}
@@ -153,6 +197,48 @@ func resourceLiteLLMTeamRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("blocked", GetBoolValue(teamResp.Blocked, d.Get("blocked").(bool)))
 
+	// The proxy persists guardrails and tags in the team metadata, so fall
+	// back to it when they are not returned as top-level fields
+	guardrails := teamResp.Guardrails
+	if guardrails == nil {
+		guardrails = metadataStringList(teamResp.Metadata, "guardrails")
+	}
+	if guardrails == nil {
+		guardrails = []string{}
+	}
+	d.Set("guardrails", guardrails)
+	tags := teamResp.Tags
+	if tags == nil {
+		tags = metadataStringList(teamResp.Metadata, "tags")
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	d.Set("tags", tags)
+
+	modelAliases := teamResp.ModelAliases
+	if modelAliases == nil && teamResp.LiteLLMModelTable != nil {
+		modelAliases = teamResp.LiteLLMModelTable.ModelAliases
+	}
+	if modelAliases == nil {
+		modelAliases = map[string]interface{}{}
+	}
+	d.Set("model_aliases", modelAliases)
+
+	if teamResp.SoftBudget != nil {
+		d.Set("soft_budget", *teamResp.SoftBudget)
+	}
+	if teamResp.TeamMemberBudget != nil {
+		d.Set("team_member_budget", *teamResp.TeamMemberBudget)
+	}
+	if teamResp.TeamMemberRPMLimit != nil {
+		d.Set("team_member_rpm_limit", *teamResp.TeamMemberRPMLimit)
+	}
+	if teamResp.TeamMemberTPMLimit != nil {
+		d.Set("team_member_tpm_limit", *teamResp.TeamMemberTPMLimit)
+	}
+	d.Set("object_permission", flattenObjectPermission(teamResp.ObjectPermission))
+
 	// Explicitly fetch the current permissions from the API
 	permResp, err := getTeamPermissions(client, d.Id())
 	if err != nil {
@@ -189,6 +275,12 @@ func resourceLiteLLMTeamUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if d.HasChange("blocked") {
+		if err := setTeamBlocked(client, d.Id(), d.Get("blocked").(bool)); err != nil {
+			return err
+		}
+	}
+
 	// Check if team_member_permissions have changed and explicitly update them
 	if d.HasChange("team_member_permissions") {
 		_, newPerms := d.GetChange("team_member_permissions")
@@ -240,15 +332,138 @@ func buildTeamData(d *schema.ResourceData, teamID string) map[string]interface{}
 		"team_alias": d.Get("team_alias").(string),
 	}
 
-	for _, key := range []string{"organization_id", "metadata", "tpm_limit", "rpm_limit", "max_budget", "budget_duration", "models", "blocked", "team_member_permissions"} {
+	for _, key := range []string{
+		"organization_id", "metadata", "tpm_limit", "rpm_limit", "max_budget", "budget_duration", "models",
+		"team_member_permissions", "guardrails", "tags", "model_aliases", "soft_budget",
+		"team_member_budget", "team_member_rpm_limit", "team_member_tpm_limit",
+	} {
 		if v, ok := d.GetOk(key); ok {
 			teamData[key] = v
 		}
 	}
 
+	// Lists emptied in configuration are sent explicitly so the proxy clears them
+	for key, empty := range map[string]interface{}{
+		"guardrails":    []interface{}{},
+		"tags":          []interface{}{},
+		"model_aliases": map[string]interface{}{},
+	} {
+		if _, ok := teamData[key]; !ok && d.HasChange(key) {
+			teamData[key] = empty
+		}
+	}
+
+	// Limits removed from configuration are sent as null to lift them
+	for _, key := range []string{"soft_budget", "team_member_budget", "team_member_rpm_limit", "team_member_tpm_limit"} {
+		if _, ok := teamData[key]; !ok && d.HasChange(key) {
+			teamData[key] = nil
+		}
+	}
+
+	if objectPermission := expandObjectPermission(d); objectPermission != nil {
+		teamData["object_permission"] = objectPermission
+	}
+
 	return teamData
 }
 
+// setTeamBlocked blocks or unblocks a team through the dedicated endpoints,
+// which also invalidate the proxy's cached copy of the team.
+func setTeamBlocked(client *Client, teamID string, blocked bool) error {
+	endpoint, action := endpointTeamUnblock, "unblocking team"
+	if blocked {
+		endpoint, action = endpointTeamBlock, "blocking team"
+	}
+
+	resp, err := MakeRequest(client, "POST", endpoint, map[string]interface{}{"team_id": teamID})
+	if err != nil {
+		return fmt.Errorf("error %s: %w", action, err)
+	}
+	defer resp.Body.Close()
+
+	return handleResponse(resp, action)
+}
+
+// teamReservedMetadataKeys are metadata keys the proxy uses to store team
+// attributes that the provider manages as first-class arguments.
+var teamReservedMetadataKeys = []string{"guardrails", "tags", "team_member_budget_id", "model_rpm_limit", "model_tpm_limit"}
+
+// resourceLiteLLMTeamImport imports a team by ID, or by alias when the import
+// ID has the form "team_alias=<alias>".
+func resourceLiteLLMTeamImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if teamAlias, ok := parseImportAlias(d.Id(), "team_alias"); ok {
+		teamID, err := findTeamIDByAlias(m.(*Client), teamAlias)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(teamID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findTeamIDByAlias looks up the ID of the team with the given alias.
+func findTeamIDByAlias(client *Client, teamAlias string) (string, error) {
+	teams, err := ListTeams(client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, team := range teams {
+		if team.TeamAlias == teamAlias {
+			ids = append(ids, team.TeamID)
+		}
+	}
+	return resolveImportAlias("team", "team_alias", teamAlias, ids)
+}
+
+// getTeamInfo fetches a team from /team/info. It returns nil without an error
+// when the team does not exist.
+func getTeamInfo(client *Client, teamID string) (*TeamResponse, error) {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?team_id=%s", endpointTeamInfo, teamID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading team: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := handleResponse(resp, "reading team"); err != nil {
+		return nil, err
+	}
+
+	return decodeTeamInfoResponse(resp)
+}
+
+// decodeTeamInfoResponse decodes a /team/info response. The proxy wraps the
+// team in a "team_info" envelope; a bare team object is accepted as well.
+func decodeTeamInfoResponse(resp *http.Response) (*TeamResponse, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading team info response: %w", err)
+	}
+
+	var envelope TeamInfoResponse
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("error decoding team info response: %w", err)
+	}
+	if envelope.TeamInfo != nil {
+		// Membership budgets are returned next to team_info rather than inside it
+		if len(envelope.TeamInfo.TeamMemberships) == 0 {
+			envelope.TeamInfo.TeamMemberships = envelope.TeamMemberships
+		}
+		return envelope.TeamInfo, nil
+	}
+
+	var teamResp TeamResponse
+	if err := json.Unmarshal(body, &teamResp); err != nil {
+		return nil, fmt.Errorf("error decoding team info response: %w", err)
+	}
+	return &teamResp, nil
+}
+
 func handleResponse(resp *http.Response, action string) error {
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,15 +15,20 @@ func resourceLiteLLMTeamMember() *schema.Resource {
 		Read:   resourceLiteLLMTeamMemberRead,
 		Update: resourceLiteLLMTeamMemberUpdate,
 		Delete: resourceLiteLLMTeamMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLiteLLMTeamMemberImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"team_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"user_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"user_email": {
 				Type:     schema.TypeString,
@@ -83,13 +89,65 @@ func resourceLiteLLMTeamMemberCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceLiteLLMTeamMemberRead(d *schema.ResourceData, m interface{}) error {
-	// There's no specific endpoint to read a single team member
-	// We might need to read the entire team and find the member
-	// For now, we'll just return the data we have in the state
+	client := m.(*Client)
+
 	log.Printf("[INFO] Reading team member with ID: %s", d.Id())
+
+	teamID, userID, err := parseMemberID(d.Id(), "team_id")
+	if err != nil {
+		return err
+	}
+
+	// There's no endpoint to read a single team member, so find it in the team
+	teamResp, err := getTeamInfo(client, teamID)
+	if err != nil {
+		return err
+	}
+	if teamResp == nil {
+		log.Printf("[WARN] Team with ID %s not found, removing team member from state", teamID)
+		d.SetId("")
+		return nil
+	}
+
+	var member *TeamMember
+	for i := range teamResp.MembersWithRoles {
+		if teamResp.MembersWithRoles[i].UserID == userID {
+			member = &teamResp.MembersWithRoles[i]
+			break
+		}
+	}
+	if member == nil {
+		log.Printf("[WARN] User %s is no longer a member of team %s, removing from state", userID, teamID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("user_id", userID)
+	d.Set("role", member.Role)
+	d.Set("user_email", GetStringValue(member.UserEmail, d.Get("user_email").(string)))
+
+	for _, membership := range teamResp.TeamMemberships {
+		if membership.UserID == userID && membership.LiteLLMBudgetTable != nil && membership.LiteLLMBudgetTable.MaxBudget != nil {
+			d.Set("max_budget_in_team", *membership.LiteLLMBudgetTable.MaxBudget)
+		}
+	}
+
 	return nil
 }
 
+func resourceLiteLLMTeamMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	teamID, userID, err := parseMemberID(d.Id(), "team_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("user_id", userID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceLiteLLMTeamMemberUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
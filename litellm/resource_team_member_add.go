@@ -101,8 +101,39 @@ func resourceLiteLLMTeamMemberAddCreate(d *schema.ResourceData, m interface{}) e
 }
 
 func resourceLiteLLMTeamMemberAddRead(d *schema.ResourceData, m interface{}) error {
-	// The API doesn't provide a way to read specific team members
-	// We'll maintain the state as is
+	client := m.(*Client)
+	teamID := d.Get("team_id").(string)
+	if teamID == "" {
+		teamID = d.Id()
+	}
+
+	log.Printf("[INFO] Reading members of team with ID: %s", teamID)
+
+	teamResp, err := getTeamInfo(client, teamID)
+	if err != nil {
+		return err
+	}
+	if teamResp == nil {
+		log.Printf("[WARN] Team with ID %s not found, removing team members from state", teamID)
+		d.SetId("")
+		return nil
+	}
+
+	serverMembers := make([]map[string]interface{}, 0, len(teamResp.MembersWithRoles))
+	for _, member := range teamResp.MembersWithRoles {
+		serverMembers = append(serverMembers, map[string]interface{}{
+			"user_id":    member.UserID,
+			"user_email": member.UserEmail,
+			"role":       member.Role,
+		})
+	}
+
+	d.Set("team_id", teamID)
+	members := reconcileMemberSet(d.Get("member").(*schema.Set).List(), serverMembers)
+	if err := d.Set("member", members); err != nil {
+		return fmt.Errorf("error setting team members: %w", err)
+	}
+
 	return nil
 }
 
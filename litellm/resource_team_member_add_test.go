@@ -0,0 +1,90 @@
+package litellm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTeamMemberAddReadDetectsDrift(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/team/info" || r.URL.Query().Get("team_id") != "team-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"team_id": "team-1",
+			"team_info": {
+				"team_id": "team-1",
+				"members_with_roles": [
+					{"user_id": "alice", "user_email": null, "role": "user"},
+					{"user_id": "u-bob", "user_email": "Bob@example.com", "role": "user"},
+					{"user_id": "mallory", "role": "admin"}
+				]
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMTeamMemberAdd().Schema, map[string]interface{}{
+		"team_id": "team-1",
+		"member": []interface{}{
+			map[string]interface{}{"user_id": "alice", "role": "admin"},
+			map[string]interface{}{"user_email": "bob@example.com", "role": "user"},
+			map[string]interface{}{"user_id": "carol", "role": "user"},
+		},
+	})
+	d.SetId("team-1")
+
+	if err := resourceLiteLLMTeamMemberAddRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	members := d.Get("member").(*schema.Set).List()
+	if len(members) != 2 {
+		t.Fatalf("expected removed member to be dropped and unmanaged member ignored, got %v", members)
+	}
+	for _, raw := range members {
+		member := raw.(map[string]interface{})
+		switch {
+		case member["user_id"] == "alice":
+			if member["role"] != "user" {
+				t.Fatalf("expected role change to be read back, got %v", member)
+			}
+		case member["user_email"] == "bob@example.com":
+			if member["user_id"] != "" {
+				t.Fatalf("expected email-only member to keep its configured identity, got %v", member)
+			}
+		default:
+			t.Fatalf("unexpected member in state: %v", member)
+		}
+	}
+}
+
+func TestTeamMemberAddReadRemovesMissingTeam(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"detail":{"error":"Team not found"}}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMTeamMemberAdd().Schema, map[string]interface{}{
+		"team_id": "team-1",
+		"member": []interface{}{
+			map[string]interface{}{"user_id": "alice", "role": "user"},
+		},
+	})
+	d.SetId("team-1")
+
+	if err := resourceLiteLLMTeamMemberAddRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected team members to be removed from state, got ID %q", d.Id())
+	}
+}
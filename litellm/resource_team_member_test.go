@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -42,3 +43,81 @@ func TestTeamMemberUpdateSendsRole(t *testing.T) {
 		t.Fatalf("update payload sent role %v, want user", role)
 	}
 }
+
+func TestTeamMemberReadFromTeamInfo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"team_id": "team-1",
+			"team_info": {
+				"team_id": "team-1",
+				"members_with_roles": [
+					{"user_id": "user-1", "user_email": "user@example.com", "role": "admin"},
+					{"user_id": "user-2", "role": "user"}
+				]
+			},
+			"team_memberships": [
+				{"user_id": "user-1", "team_id": "team-1", "litellm_budget_table": {"max_budget": 50}}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMTeamMember().Schema, map[string]interface{}{})
+	d.SetId("team-1:user-1")
+
+	importer := resourceLiteLLMTeamMember().Importer
+	if _, err := importer.StateContext(context.Background(), d, client); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if err := resourceLiteLLMTeamMemberRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if d.Get("team_id").(string) != "team-1" || d.Get("user_id").(string) != "user-1" {
+		t.Fatalf("identity not populated from ID: team_id=%q user_id=%q", d.Get("team_id"), d.Get("user_id"))
+	}
+	if d.Get("role").(string) != "admin" {
+		t.Fatalf("expected role admin, got %q", d.Get("role"))
+	}
+	if d.Get("user_email").(string) != "user@example.com" {
+		t.Fatalf("expected user_email to be read back, got %q", d.Get("user_email"))
+	}
+	if d.Get("max_budget_in_team").(float64) != 50 {
+		t.Fatalf("expected max_budget_in_team 50, got %v", d.Get("max_budget_in_team"))
+	}
+}
+
+func TestTeamMemberReadRemovesMissingMembership(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"team_id": "team-1", "team_info": {"team_id": "team-1", "members_with_roles": []}}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMTeamMember().Schema, map[string]interface{}{
+		"team_id":    "team-1",
+		"user_id":    "user-1",
+		"user_email": "user@example.com",
+		"role":       "user",
+	})
+	d.SetId("team-1:user-1")
+
+	if err := resourceLiteLLMTeamMemberRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected team member to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func TestTeamMemberImportRejectsMalformedID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMTeamMember().Schema, map[string]interface{}{})
+	d.SetId("team-1")
+
+	if _, err := resourceLiteLLMTeamMemberImport(context.Background(), d, nil); err == nil {
+		t.Fatal("expected an error for an ID without a user_id")
+	}
+}
@@ -0,0 +1,282 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestTeamReadDetectsGuardrailAndTagDrift(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/team/info" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{
+			"team_id": "team-1",
+			"team_info": {
+				"team_id": "team-1",
+				"team_alias": "eng",
+				"metadata": {"department": "eng", "guardrails": ["pii-masking"], "tags": ["prod"]},
+				"models": [],
+				"team_member_budget": 25,
+				"litellm_model_table": {"model_aliases": {"fast": "gpt-4o-mini"}}
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, ResourceLiteLLMTeam().Schema, map[string]interface{}{
+		"team_alias": "eng",
+		"guardrails": []interface{}{"pii-masking", "prompt-injection"},
+		"metadata":   map[string]interface{}{"department": "eng"},
+	})
+	d.SetId("team-1")
+
+	if err := resourceLiteLLMTeamRead(d, client); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if got := d.Get("guardrails").([]interface{}); len(got) != 1 || got[0] != "pii-masking" {
+		t.Fatalf("guardrails not read back from team metadata: %v", got)
+	}
+	if got := d.Get("tags").([]interface{}); len(got) != 1 || got[0] != "prod" {
+		t.Fatalf("tags not read back from team metadata: %v", got)
+	}
+	metadata := d.Get("metadata").(map[string]interface{})
+	if _, leaked := metadata["guardrails"]; leaked || metadata["department"] != "eng" {
+		t.Fatalf("reserved keys not stripped from metadata: %v", metadata)
+	}
+	if got := d.Get("model_aliases").(map[string]interface{}); got["fast"] != "gpt-4o-mini" {
+		t.Fatalf("model_aliases not read back: %v", got)
+	}
+	if got := d.Get("team_member_budget").(float64); got != 25 {
+		t.Fatalf("team_member_budget not read back: %v", got)
+	}
+}
+
+func TestBuildTeamDataIncludesMemberLimits(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceLiteLLMTeam().Schema, map[string]interface{}{
+		"team_alias":            "eng",
+		"tags":                  []interface{}{"prod"},
+		"soft_budget":           50.0,
+		"team_member_budget":    10.0,
+		"team_member_rpm_limit": 100,
+		"team_member_tpm_limit": 1000,
+	})
+
+	body, err := json.Marshal(buildTeamData(d, "team-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload map[string]interface{}
+	json.Unmarshal(body, &payload)
+
+	for key, want := range map[string]interface{}{
+		"soft_budget":           50.0,
+		"team_member_budget":    10.0,
+		"team_member_rpm_limit": 100.0,
+		"team_member_tpm_limit": 1000.0,
+	} {
+		if payload[key] != want {
+			t.Errorf("payload[%s] = %v, want %v", key, payload[key], want)
+		}
+	}
+	if _, ok := payload["tags"]; !ok {
+		t.Errorf("payload missing tags: %v", payload)
+	}
+}
+
+func TestTeamImportByAlias(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/team/list" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[
+			{"team_id": "team-1", "team_alias": "platform"},
+			{"team_id": "team-2", "team_alias": "research"},
+			{"team_id": "team-3", "team_alias": "research"}
+		]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	importer := ResourceLiteLLMTeam().Importer
+
+	d := schema.TestResourceDataRaw(t, ResourceLiteLLMTeam().Schema, map[string]interface{}{})
+	d.SetId("team_alias=platform")
+	if _, err := importer.StateContext(context.Background(), d, client); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if d.Id() != "team-1" {
+		t.Fatalf("expected alias to resolve to team-1, got %q", d.Id())
+	}
+
+	d.SetId("team_alias=research")
+	if _, err := importer.StateContext(context.Background(), d, client); err == nil {
+		t.Fatal("expected an error for an ambiguous team alias")
+	}
+
+	d.SetId("team_alias=missing")
+	if _, err := importer.StateContext(context.Background(), d, client); err == nil {
+		t.Fatal("expected an error for an unknown team alias")
+	}
+}
+
+func TestTeamBlockStateUsesBlockEndpoints(t *testing.T) {
+	var calls []string
+	var updateBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		calls = append(calls, r.URL.Path)
+		switch r.URL.Path {
+		case "/team/update":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			w.Write([]byte(`{}`))
+		case "/team/block", "/team/unblock":
+			w.Write([]byte(`{}`))
+		case "/team/info":
+			w.Write([]byte(`{"team_id": "team-1", "team_info": {"team_id": "team-1", "team_alias": "eng", "blocked": false}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "test-key", true)
+
+	res := ResourceLiteLLMTeam()
+	state := &terraform.InstanceState{
+		ID: "team-1",
+		Attributes: map[string]string{
+			"id":         "team-1",
+			"team_alias": "eng",
+			"blocked":    "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"team_alias": "eng",
+		"blocked":    false,
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if _, diags := res.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("apply failed: %v", diags)
+	}
+	if _, ok := updateBody["blocked"]; ok {
+		t.Fatalf("blocked must not be sent to /team/update: %v", updateBody)
+	}
+	if !slices.Contains(calls, "/team/unblock") {
+		t.Fatalf("expected /team/unblock to be called, got %v", calls)
+	}
+}
+
+func TestTeamListsRemovedOnProxyOrInConfigAreCleared(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/team/info" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"team_id": "team-1", "team_info": {"team_id": "team-1", "team_alias": "eng", "metadata": {}}}`))
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceLiteLLMTeam().Schema, map[string]interface{}{
+		"team_alias":    "eng",
+		"guardrails":    []interface{}{"pii-masking"},
+		"tags":          []interface{}{"prod"},
+		"model_aliases": map[string]interface{}{"fast": "gpt-4o-mini"},
+	})
+	d.SetId("team-1")
+	if err := resourceLiteLLMTeamRead(d, NewClient(srv.URL, "test-key", true)); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if len(d.Get("guardrails").([]interface{})) != 0 || len(d.Get("tags").([]interface{})) != 0 || len(d.Get("model_aliases").(map[string]interface{})) != 0 {
+		t.Fatalf("lists removed on the proxy were kept in state: %v", d.State())
+	}
+
+	state := &terraform.InstanceState{ID: "team-1", Attributes: map[string]string{
+		"team_alias":         "eng",
+		"tags.#":             "1",
+		"tags.0":             "prod",
+		"model_aliases.%":    "1",
+		"model_aliases.fast": "gpt-4o-mini",
+	}}
+	res := ResourceLiteLLMTeam()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"team_alias": "eng"}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(res.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(buildTeamData(d, "team-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload map[string]interface{}
+	json.Unmarshal(body, &payload)
+	if tags, ok := payload["tags"].([]interface{}); !ok || len(tags) != 0 {
+		t.Errorf("expected an empty tags list, got %v", payload["tags"])
+	}
+	if aliases, ok := payload["model_aliases"].(map[string]interface{}); !ok || len(aliases) != 0 {
+		t.Errorf("expected empty model_aliases, got %v", payload["model_aliases"])
+	}
+	if _, ok := payload["guardrails"]; ok {
+		t.Errorf("unchanged guardrails should not be sent: %v", payload["guardrails"])
+	}
+}
+
+func TestTeamLimitsRemovedInConfigAreSentAsNull(t *testing.T) {
+	state := &terraform.InstanceState{ID: "team-1", Attributes: map[string]string{
+		"team_alias":            "eng",
+		"soft_budget":           "50",
+		"team_member_budget":    "10",
+		"team_member_rpm_limit": "100",
+	}}
+	res := ResourceLiteLLMTeam()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"team_alias":            "eng",
+		"team_member_rpm_limit": 100,
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(buildTeamData(d, "team-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload map[string]interface{}
+	json.Unmarshal(body, &payload)
+	for _, key := range []string{"soft_budget", "team_member_budget"} {
+		if v, ok := payload[key]; !ok || v != nil {
+			t.Errorf("expected %s to be sent as null, got %v", key, payload)
+		}
+	}
+	if payload["team_member_rpm_limit"] != 100.0 {
+		t.Errorf("expected the kept team_member_rpm_limit, got %v", payload["team_member_rpm_limit"])
+	}
+	if _, ok := payload["team_member_tpm_limit"]; ok {
+		t.Errorf("unchanged team_member_tpm_limit should not be sent: %v", payload)
+	}
+}
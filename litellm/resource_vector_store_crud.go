@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -17,6 +18,12 @@ func resourceLiteLLMVectorStoreCreate(d *schema.ResourceData, m interface{}) err
 	litellmCredentialName := d.Get("litellm_credential_name").(string)
 	litellmParams := d.Get("litellm_params").(map[string]interface{})
 
+	if litellmCredentialName != "" {
+		if err := checkCredentialExists(client, litellmCredentialName); err != nil {
+			return err
+		}
+	}
+
 	// Convert metadata to map[string]interface{} for JSON
 	metadataMap := make(map[string]interface{})
 	for k, v := range vectorStoreMetadata {
@@ -103,6 +110,36 @@ func resourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
+// resourceLiteLLMVectorStoreImport imports a vector store by ID, or by name
+// when the import ID has the form "vector_store_name=<name>".
+func resourceLiteLLMVectorStoreImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if vectorStoreName, ok := parseImportAlias(d.Id(), "vector_store_name"); ok {
+		vectorStoreID, err := findVectorStoreIDByName(m.(*Client), vectorStoreName)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(vectorStoreID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findVectorStoreIDByName looks up the ID of the vector store with the given name.
+func findVectorStoreIDByName(client *Client, vectorStoreName string) (string, error) {
+	vectorStores, err := ListVectorStores(client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, vectorStore := range vectorStores {
+		if vectorStore.VectorStoreName == vectorStoreName {
+			ids = append(ids, vectorStore.VectorStoreID)
+		}
+	}
+	return resolveImportAlias("vector store", "vector_store_name", vectorStoreName, ids)
+}
+
 func resourceLiteLLMVectorStoreUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 	vectorStoreID := d.Id()
@@ -1,9 +1,11 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,3 +55,52 @@ func TestVectorStoreReadDoesNotPersistServerLitellmParams(t *testing.T) {
 		t.Fatalf("read did not populate non-sensitive fields")
 	}
 }
+
+func TestVectorStoreImportByNameWalksPages(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/vector_store/list" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		data := []VectorStoreResponse{{VectorStoreID: "vs-1", VectorStoreName: "docs"}}
+		if page == "2" {
+			data = []VectorStoreResponse{{VectorStoreID: "vs-2", VectorStoreName: "kb"}}
+		}
+		json.NewEncoder(w).Encode(VectorStoreListResponse{Data: data, TotalPages: 2})
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "test-key", true)
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMVectorStore().Schema, map[string]interface{}{})
+	d.SetId("vector_store_name=kb")
+
+	if _, err := resourceLiteLLMVectorStoreImport(context.Background(), d, client); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if d.Id() != "vs-2" {
+		t.Fatalf("expected vector store name to resolve to vs-2, got %q", d.Id())
+	}
+}
+
+func TestVectorStoreCreateFailsForMissingCredential(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/vector_store/new" {
+			t.Fatal("vector store created with a missing credential")
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceLiteLLMVectorStore().Schema, map[string]interface{}{
+		"vector_store_name":       "kb",
+		"custom_llm_provider":     "pinecone",
+		"litellm_credential_name": "pinecone-prod",
+	})
+	err := resourceLiteLLMVectorStoreCreate(d, NewClient(srv.URL, "test-key", true))
+	if err == nil || !strings.Contains(err.Error(), `"pinecone-prod" does not match any credential`) {
+		t.Fatalf("expected a missing credential error, got %v", err)
+	}
+}
@@ -25,6 +25,11 @@ type ModelResponse struct {
 	Additional    map[string]interface{} `json:"additional"`
 }
 
+// ModelListResponse represents the "data" envelope returned by /model/info.
+type ModelListResponse struct {
+	Data []ModelResponse `json:"data"`
+}
+
 // ModelRequest represents a request to create or update a model.
 type ModelRequest struct {
 	ModelName     string                 `json:"model_name"`
@@ -42,23 +47,85 @@ type TeamResponse struct {
 	TPMLimit              *int                   `json:"tpm_limit,omitempty"`
 	RPMLimit              *int                   `json:"rpm_limit,omitempty"`
 	MaxBudget             *float64               `json:"max_budget,omitempty"`
+	SoftBudget            *float64               `json:"soft_budget,omitempty"`
 	BudgetDuration        string                 `json:"budget_duration,omitempty"`
 	Models                []string               `json:"models"`
 	Blocked               bool                   `json:"blocked,omitempty"`
 	TeamMemberPermissions []string               `json:"team_member_permissions,omitempty"`
+	Guardrails            []string               `json:"guardrails,omitempty"`
+	Tags                  []string               `json:"tags,omitempty"`
+	ModelAliases          map[string]interface{} `json:"model_aliases,omitempty"`
+	TeamMemberBudget      *float64               `json:"team_member_budget,omitempty"`
+	TeamMemberRPMLimit    *int                   `json:"team_member_rpm_limit,omitempty"`
+	TeamMemberTPMLimit    *int                   `json:"team_member_tpm_limit,omitempty"`
+	ObjectPermission      *ObjectPermission      `json:"object_permission,omitempty"`
+	MembersWithRoles      []TeamMember           `json:"members_with_roles,omitempty"`
+	TeamMemberships       []TeamMembership       `json:"team_memberships,omitempty"`
+	Spend                 float64                `json:"spend,omitempty"`
+	LiteLLMModelTable     *struct {
+		ModelAliases map[string]interface{} `json:"model_aliases,omitempty"`
+	} `json:"litellm_model_table,omitempty"`
+}
+
+// TeamMember represents a member entry in a team's members_with_roles list.
+type TeamMember struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	Role      string `json:"role"`
+}
+
+// TeamMembership represents a user's membership record in a team, which
+// carries the member's budget within the team.
+type TeamMembership struct {
+	UserID             string       `json:"user_id"`
+	TeamID             string       `json:"team_id"`
+	LiteLLMBudgetTable *BudgetTable `json:"litellm_budget_table,omitempty"`
+}
+
+// TeamInfoResponse represents the envelope returned by /team/info.
+type TeamInfoResponse struct {
+	TeamID          string           `json:"team_id"`
+	TeamInfo        *TeamResponse    `json:"team_info"`
+	TeamMemberships []TeamMembership `json:"team_memberships,omitempty"`
+}
+
+// BudgetTable represents a LiteLLM budget attached to an organization or team member.
+type BudgetTable struct {
+	MaxBudget      *float64 `json:"max_budget,omitempty"`
+	SoftBudget     *float64 `json:"soft_budget,omitempty"`
+	TPMLimit       *int     `json:"tpm_limit,omitempty"`
+	RPMLimit       *int     `json:"rpm_limit,omitempty"`
+	BudgetDuration string   `json:"budget_duration,omitempty"`
 }
 
 // OrganizationResponse represents a response from the API containing organization information.
 type OrganizationResponse struct {
-	OrganizationID    string                 `json:"organization_id,omitempty"`
-	OrganizationAlias string                 `json:"organization_alias,omitempty"`
-	Metadata          map[string]interface{} `json:"metadata,omitempty"`
-	Models            []string               `json:"models,omitempty"`
-	MaxBudget         *float64               `json:"max_budget,omitempty"`
-	BudgetDuration    string                 `json:"budget_duration,omitempty"`
-	TPMLimit          *int                   `json:"tpm_limit,omitempty"`
-	RPMLimit          *int                   `json:"rpm_limit,omitempty"`
-	Blocked           bool                   `json:"blocked,omitempty"`
+	OrganizationID     string                 `json:"organization_id,omitempty"`
+	OrganizationAlias  string                 `json:"organization_alias,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+	Models             []string               `json:"models,omitempty"`
+	MaxBudget          *float64               `json:"max_budget,omitempty"`
+	SoftBudget         *float64               `json:"soft_budget,omitempty"`
+	BudgetDuration     string                 `json:"budget_duration,omitempty"`
+	TPMLimit           *int                   `json:"tpm_limit,omitempty"`
+	RPMLimit           *int                   `json:"rpm_limit,omitempty"`
+	Blocked            bool                   `json:"blocked,omitempty"`
+	Guardrails         []string               `json:"guardrails,omitempty"`
+	Tags               []string               `json:"tags,omitempty"`
+	LiteLLMBudgetTable *BudgetTable           `json:"litellm_budget_table,omitempty"`
+	ObjectPermission   *ObjectPermission      `json:"object_permission,omitempty"`
+	Members            []OrganizationMember   `json:"members,omitempty"`
+	Spend              float64                `json:"spend,omitempty"`
+}
+
+// OrganizationMember represents an organization membership returned by /organization/info.
+type OrganizationMember struct {
+	UserID   string `json:"user_id"`
+	UserRole string `json:"user_role,omitempty"`
+	User     *struct {
+		UserEmail string `json:"user_email,omitempty"`
+	} `json:"user,omitempty"`
+	LiteLLMBudgetTable *BudgetTable `json:"litellm_budget_table,omitempty"`
 }
 
 // LiteLLMParams represents the parameters for LiteLLM.
@@ -87,16 +154,33 @@ type LiteLLMParams struct {
 	VertexProject                  string                 `json:"vertex_project,omitempty"`
 	VertexLocation                 string                 `json:"vertex_location,omitempty"`
 	VertexCredentials              string                 `json:"vertex_credentials,omitempty"`
+	LiteLLMCredentialName          string                 `json:"litellm_credential_name,omitempty"`
+	Weight                         float64                `json:"weight,omitempty"`
+	Order                          int                    `json:"order,omitempty"`
+	RegionName                     string                 `json:"region_name,omitempty"`
 }
 
 // ModelInfo represents information about a model.
 type ModelInfo struct {
-	ID        string `json:"id"`
-	DBModel   bool   `json:"db_model"`
-	BaseModel string `json:"base_model"`
-	Tier      string `json:"tier"`
-	Mode      string `json:"mode"`
-	TeamID    string `json:"team_id,omitempty"`
+	ID                                string   `json:"id"`
+	DBModel                           bool     `json:"db_model"`
+	BaseModel                         string   `json:"base_model"`
+	Tier                              string   `json:"tier"`
+	Mode                              string   `json:"mode"`
+	TeamID                            string   `json:"team_id,omitempty"`
+	MaxTokens                         *int     `json:"max_tokens,omitempty"`
+	MaxInputTokens                    *int     `json:"max_input_tokens,omitempty"`
+	MaxOutputTokens                   *int     `json:"max_output_tokens,omitempty"`
+	SupportsVision                    *bool    `json:"supports_vision,omitempty"`
+	SupportsFunctionCalling           *bool    `json:"supports_function_calling,omitempty"`
+	SupportsPromptCaching             *bool    `json:"supports_prompt_caching,omitempty"`
+	AccessGroups                      []string `json:"access_groups,omitempty"`
+	CacheReadInputTokenCost           *float64 `json:"cache_read_input_token_cost,omitempty"`
+	CacheCreationInputTokenCost       *float64 `json:"cache_creation_input_token_cost,omitempty"`
+	InputCostPerTokenAbove128kTokens  *float64 `json:"input_cost_per_token_above_128k_tokens,omitempty"`
+	OutputCostPerTokenAbove128kTokens *float64 `json:"output_cost_per_token_above_128k_tokens,omitempty"`
+	InputCostPerTokenAbove200kTokens  *float64 `json:"input_cost_per_token_above_200k_tokens,omitempty"`
+	OutputCostPerTokenAbove200kTokens *float64 `json:"output_cost_per_token_above_200k_tokens,omitempty"`
 }
 
 // Key represents a LiteLLM API key.
@@ -126,6 +210,11 @@ type Key struct {
 	Guardrails           []string               `json:"guardrails,omitempty"`
 	Blocked              bool                   `json:"blocked"`
 	Tags                 []string               `json:"tags,omitempty"`
+	ObjectPermission     *ObjectPermission      `json:"object_permission,omitempty"`
+	OrganizationID       string                 `json:"organization_id,omitempty"`
+	KeyName              string                 `json:"key_name,omitempty"`
+	Expires              string                 `json:"expires,omitempty"`
+	CreatedAt            string                 `json:"created_at,omitempty"`
 }
 
 // KeyResponse represents a response from the API containing key information.
@@ -246,3 +335,75 @@ type VectorStoreDeleteRequest struct {
 type VectorStoreInfoRequest struct {
 	VectorStoreID string `json:"vector_store_id"`
 }
+
+// Guardrail represents a guardrail definition sent to the proxy.
+type Guardrail struct {
+	GuardrailName string                 `json:"guardrail_name"`
+	LiteLLMParams map[string]interface{} `json:"litellm_params"`
+	GuardrailInfo map[string]interface{} `json:"guardrail_info,omitempty"`
+}
+
+// GuardrailRequest represents a request to create or update a guardrail.
+type GuardrailRequest struct {
+	Guardrail Guardrail `json:"guardrail"`
+}
+
+// GuardrailLiteLLMParams represents the non-secret litellm_params of a guardrail.
+type GuardrailLiteLLMParams struct {
+	Guardrail string      `json:"guardrail"`
+	Mode      interface{} `json:"mode"`
+	DefaultOn bool        `json:"default_on"`
+	APIBase   string      `json:"api_base,omitempty"`
+}
+
+// GuardrailResponse represents a response from the API containing guardrail information.
+type GuardrailResponse struct {
+	GuardrailID   string                 `json:"guardrail_id"`
+	GuardrailName string                 `json:"guardrail_name"`
+	LiteLLMParams GuardrailLiteLLMParams `json:"litellm_params"`
+	GuardrailInfo map[string]interface{} `json:"guardrail_info,omitempty"`
+	CreatedAt     string                 `json:"created_at,omitempty"`
+	UpdatedAt     string                 `json:"updated_at,omitempty"`
+}
+
+// ObjectPermission scopes which MCP servers and vector stores a key, team or
+// organization may use.
+type ObjectPermission struct {
+	MCPServers         []string            `json:"mcp_servers"`
+	MCPAccessGroups    []string            `json:"mcp_access_groups"`
+	VectorStores       []string            `json:"vector_stores"`
+	MCPToolPermissions map[string][]string `json:"mcp_tool_permissions"`
+}
+
+// ConfigUpdateRequest is the body of /config/update. The proxy merges each
+// section into the config stored in its database.
+type ConfigUpdateRequest struct {
+	RouterSettings map[string]interface{} `json:"router_settings,omitempty"`
+}
+
+// ConfigCallbacksResponse is the part of the /get/config/callbacks response
+// holding the router's current settings.
+type ConfigCallbacksResponse struct {
+	RouterSettings map[string]interface{} `json:"router_settings"`
+}
+
+// ConfigFieldUpdateRequest is the body of /config/field/update, which sets one
+// key of a config section stored in the proxy database.
+type ConfigFieldUpdateRequest struct {
+	FieldName  string      `json:"field_name"`
+	FieldValue interface{} `json:"field_value"`
+	ConfigType string      `json:"config_type"`
+}
+
+// ConfigFieldDeleteRequest is the body of /config/field/delete.
+type ConfigFieldDeleteRequest struct {
+	FieldName  string `json:"field_name"`
+	ConfigType string `json:"config_type"`
+}
+
+// ConfigFieldInfoResponse is the response of /config/field/info.
+type ConfigFieldInfoResponse struct {
+	FieldName  string      `json:"field_name"`
+	FieldValue interface{} `json:"field_value"`
+	StoredInDB bool        `json:"stored_in_db"`
+}
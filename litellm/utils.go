@@ -2,11 +2,18 @@ package litellm
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func isModelNotFoundError(errResp ErrorResponse) bool {
@@ -52,6 +59,15 @@ func handleAPIResponse(resp *http.Response, reqBody interface{}, client *Client)
 			resp.Status, client.redactSensitiveData(string(bodyBytes)), client.redactSensitiveData(string(reqBodyBytes)))
 	}
 
+	// /model/info wraps the model in a "data" list; create and update return it bare
+	var envelope ModelListResponse
+	if err := json.Unmarshal(bodyBytes, &envelope); err == nil && envelope.Data != nil {
+		if len(envelope.Data) == 0 {
+			return nil, fmt.Errorf("model_not_found")
+		}
+		return &envelope.Data[0], nil
+	}
+
 	var modelResp ModelResponse
 	if err := json.Unmarshal(bodyBytes, &modelResp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %v", err)
@@ -85,6 +101,44 @@ func MakeRequest(client *Client, method, endpoint string, body interface{}) (*ht
 	return client.httpClient.Do(req)
 }
 
+// parseAdditionalParamValue converts a string from an additional params map into
+// the JSON type the proxy expects: JSON arrays/objects are decoded, "true"/"false"
+// become booleans, numeric strings become numbers and anything else stays a string.
+func parseAdditionalParamValue(value string) interface{} {
+	trimmedValue := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmedValue, "[") || strings.HasPrefix(trimmedValue, "{") {
+		var parsedValue interface{}
+		if err := json.Unmarshal([]byte(value), &parsedValue); err == nil {
+			return parsedValue
+		}
+	}
+
+	if value == "true" {
+		return true
+	}
+	if value == "false" {
+		return false
+	}
+	if intValue, err := strconv.Atoi(value); err == nil {
+		return intValue
+	}
+	if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+		return floatValue
+	}
+	return value
+}
+
+// getWriteOnlyString returns the configured value of a write-only attribute.
+// Write-only values are never persisted to plan or state, so d.Get always
+// returns the zero value for them and they must be read from the raw config.
+func getWriteOnlyString(d *schema.ResourceData, path cty.Path) string {
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() || value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
+
 // Helper functions to handle potential nil values from the API response
 func GetStringValue(apiValue, defaultValue string) string {
 	if apiValue != "" {
@@ -277,3 +331,231 @@ func handleVectorStoreAPIResponse(resp *http.Response, result interface{}, clien
 
 	return nil
 }
+
+// isGuardrailNotFoundError checks if the error response indicates a guardrail not found
+func isGuardrailNotFoundError(errResp ErrorResponse) bool {
+	if msg, ok := errResp.Error.Message.(string); ok {
+		if strings.Contains(msg, "guardrail not found") {
+			return true
+		}
+	}
+
+	if msgMap, ok := errResp.Error.Message.(map[string]interface{}); ok {
+		if errStr, ok := msgMap["error"].(string); ok {
+			if strings.Contains(errStr, "Guardrail with ID") && strings.Contains(errStr, "not found") {
+				return true
+			}
+		}
+	}
+
+	// Check Detail.Error field for LiteLLM proxy error format
+	if errResp.Detail.Error != "" {
+		if strings.Contains(errResp.Detail.Error, "not found") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleGuardrailAPIResponse handles API responses specifically for guardrail operations
+func handleGuardrailAPIResponse(resp *http.Response, result interface{}, client *Client) error {
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("guardrail_not_found")
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var errResp ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &errResp); err == nil {
+			if isGuardrailNotFoundError(errResp) {
+				return fmt.Errorf("guardrail_not_found")
+			}
+		}
+		return fmt.Errorf("API request failed: Status: %s, Response: %s",
+			resp.Status, client.redactSensitiveData(string(bodyBytes)))
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(bodyBytes, result); err != nil {
+			return fmt.Errorf("failed to parse response: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// metadataStringList returns the string list the proxy stored under key in an
+// object's metadata, or nil if the key is absent.
+func metadataStringList(metadata map[string]interface{}, key string) []string {
+	items, ok := metadata[key].([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// stripReservedMetadata returns a copy of metadata without the keys the proxy
+// uses internally to persist first-class attributes (guardrails, tags, ...),
+// so those attributes do not show up as drift in the user-managed metadata map.
+func stripReservedMetadata(metadata map[string]interface{}, reserved ...string) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		result[k] = v
+	}
+	for _, k := range reserved {
+		delete(result, k)
+	}
+	return result
+}
+
+// reconcileMemberSet rebuilds a member set from the memberships the proxy
+// reports. Members already in state are matched by user_id, falling back to a
+// case-insensitive user_email match, and keep their configured identity fields
+// so email-only members do not churn once the proxy resolves a user_id. Their
+// role is taken from the proxy and members the proxy no longer lists are
+// dropped so they are re-added on the next apply. Memberships created outside
+// Terraform are ignored unless state is empty (e.g. after an import).
+func reconcileMemberSet(stateMembers []interface{}, serverMembers []map[string]interface{}) []interface{} {
+	if len(stateMembers) == 0 {
+		result := make([]interface{}, 0, len(serverMembers))
+		for _, member := range serverMembers {
+			result = append(result, member)
+		}
+		return result
+	}
+
+	byID := make(map[string]map[string]interface{}, len(serverMembers))
+	byEmail := make(map[string]map[string]interface{}, len(serverMembers))
+	for _, member := range serverMembers {
+		if userID, _ := member["user_id"].(string); userID != "" {
+			byID[userID] = member
+		}
+		if userEmail, _ := member["user_email"].(string); userEmail != "" {
+			byEmail[strings.ToLower(userEmail)] = member
+		}
+	}
+
+	result := make([]interface{}, 0, len(stateMembers))
+	for _, raw := range stateMembers {
+		member, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var found map[string]interface{}
+		if userID, _ := member["user_id"].(string); userID != "" {
+			found = byID[userID]
+		} else if userEmail, _ := member["user_email"].(string); userEmail != "" {
+			found = byEmail[strings.ToLower(userEmail)]
+		}
+		if found == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"user_id":    member["user_id"],
+			"user_email": member["user_email"],
+			"role":       found["role"],
+		})
+	}
+	return result
+}
+
+// parseMemberID splits a composite "<parent_id>:<user_id>" member resource ID.
+func parseMemberID(id, parentAttr string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected ID %q, expected <%s>:<user_id>", id, parentAttr)
+	}
+	return parts[0], parts[1], nil
+}
+
+// parseImportAlias reports whether an import ID has the form "<attr>=<value>"
+// and returns the value. Plain IDs are returned with false.
+func parseImportAlias(id, attr string) (string, bool) {
+	prefix := attr + "="
+	if !strings.HasPrefix(id, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(id, prefix), true
+}
+
+// resolveImportAlias returns the single ID matching an alias lookup, or an
+// error naming the candidates when the alias is missing or ambiguous. It is
+// used by importers and data sources alike, so the error does not name a
+// specific way of passing the ID.
+func resolveImportAlias(kind, attr, value string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s found with %s %q", kind, attr, value)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%s %q matches %d %ss (%s), use one of the IDs instead", attr, value, len(ids), kind, strings.Join(ids, ", "))
+	}
+}
+
+// stringifyMetadata converts metadata values to strings for TypeMap
+// attributes. Non-string values are JSON encoded.
+func stringifyMetadata(metadata map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		if s, ok := v.(string); ok {
+			result[k] = s
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		result[k] = string(encoded)
+	}
+	return result
+}
+
+// hashKey returns the token ID the proxy stores for a raw key: the hex-encoded
+// SHA-256 hash of the key.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// parseDuration parses a LiteLLM duration such as "30s", "12h" or "30d". Go
+// duration strings are accepted as well.
+func parseDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
+
+// parseTimestamp parses a timestamp returned by the proxy. Timestamps without
+// a time zone are taken to be UTC.
+func parseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05.999999999", value)
+}
@@ -1,14 +1,22 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/BerriAI/terraform-provider-litellm/litellm"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
-// main is the entry point for the plugin. It serves the provider
-// using the Terraform plugin SDK.
+// main is the entry point for the plugin. It serves the SDKv2 provider muxed
+// with the plugin-framework provider that implements ephemeral resources.
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: litellm.Provider,
-	})
+	serverFactory, err := litellm.ProviderServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/BerriAI/litellm", serverFactory); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,485 @@
+// Command export reads the objects of a live LiteLLM proxy and writes
+// Terraform configuration for them: one .tf file per resource type with a
+// resource block and a matching import block for every object, plus a
+// variables.tf declaring sensitive variables for the secrets the proxy does
+// not return.
+//
+// Objects are read through the provider's own Read functions, so the
+// generated configuration matches what `terraform plan` will see after the
+// import blocks are applied.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BerriAI/terraform-provider-litellm/litellm"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// listedObject is an object found on the proxy: its import ID and a
+// human-readable name used to derive the Terraform resource name.
+type listedObject struct {
+	ID   string
+	Name string
+}
+
+type exporter struct {
+	ResourceType string
+	List         func(client *litellm.Client) ([]listedObject, error)
+}
+
+// exporters lists the exported resource types in dependency order.
+var exporters = []exporter{
+	{"litellm_organization", listOrganizations},
+	{"litellm_team", listTeams},
+	{"litellm_credential", listCredentials},
+	{"litellm_model", listModels},
+	{"litellm_guardrail", listGuardrails},
+	{"litellm_mcp_server", listMCPServers},
+	{"litellm_vector_store", listVectorStores},
+	{"litellm_key", listKeys},
+}
+
+func listOrganizations(client *litellm.Client) ([]listedObject, error) {
+	orgs, err := litellm.ListOrganizations(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(orgs))
+	for _, org := range orgs {
+		objects = append(objects, listedObject{ID: org.OrganizationID, Name: org.OrganizationAlias})
+	}
+	return objects, nil
+}
+
+func listTeams(client *litellm.Client) ([]listedObject, error) {
+	teams, err := litellm.ListTeams(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(teams))
+	for _, team := range teams {
+		objects = append(objects, listedObject{ID: team.TeamID, Name: team.TeamAlias})
+	}
+	return objects, nil
+}
+
+func listCredentials(client *litellm.Client) ([]listedObject, error) {
+	credentials, err := litellm.ListCredentials(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(credentials))
+	for _, credential := range credentials {
+		objects = append(objects, listedObject{ID: credential.CredentialName, Name: credential.CredentialName})
+	}
+	return objects, nil
+}
+
+func listModels(client *litellm.Client) ([]listedObject, error) {
+	models, err := litellm.ListModels(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(models))
+	for _, model := range models {
+		// Models defined in the proxy's config.yaml are not stored in the
+		// database and cannot be managed through the API
+		if !model.ModelInfo.DBModel {
+			continue
+		}
+		objects = append(objects, listedObject{ID: model.ModelInfo.ID, Name: model.ModelName})
+	}
+	return objects, nil
+}
+
+func listGuardrails(client *litellm.Client) ([]listedObject, error) {
+	guardrails, err := litellm.ListGuardrails(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(guardrails))
+	for _, guardrail := range guardrails {
+		// Guardrails defined in config.yaml are listed without an ID
+		if guardrail.GuardrailID == "" {
+			continue
+		}
+		objects = append(objects, listedObject{ID: guardrail.GuardrailID, Name: guardrail.GuardrailName})
+	}
+	return objects, nil
+}
+
+func listMCPServers(client *litellm.Client) ([]listedObject, error) {
+	servers, err := litellm.ListMCPServers(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(servers))
+	for _, server := range servers {
+		objects = append(objects, listedObject{ID: server.ServerID, Name: server.ServerName})
+	}
+	return objects, nil
+}
+
+func listVectorStores(client *litellm.Client) ([]listedObject, error) {
+	vectorStores, err := litellm.ListVectorStores(client)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(vectorStores))
+	for _, vectorStore := range vectorStores {
+		objects = append(objects, listedObject{ID: vectorStore.VectorStoreID, Name: vectorStore.VectorStoreName})
+	}
+	return objects, nil
+}
+
+func listKeys(client *litellm.Client) ([]listedObject, error) {
+	keys, err := litellm.ListKeys(client, nil)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]listedObject, 0, len(keys))
+	for _, key := range keys {
+		if key.TokenID == "" {
+			continue
+		}
+		objects = append(objects, listedObject{ID: key.TokenID, Name: key.KeyAlias})
+	}
+	return objects, nil
+}
+
+// variable is a sensitive input variable referenced by generated resources.
+type variable struct {
+	Name string
+	Type string
+}
+
+// generator accumulates the generated files.
+type generator struct {
+	client    *litellm.Client
+	provider  *schema.Provider
+	files     map[string]*hclwrite.File
+	variables []variable
+	names     map[string]bool
+}
+
+func newGenerator(client *litellm.Client) *generator {
+	return &generator{
+		client:   client,
+		provider: litellm.Provider(),
+		files:    make(map[string]*hclwrite.File),
+		names:    make(map[string]bool),
+	}
+}
+
+// run lists every exported resource type and renders the objects found.
+func (g *generator) run(ctx context.Context) error {
+	for _, exp := range exporters {
+		objects, err := exp.List(g.client)
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", exp.ResourceType, err)
+		}
+		sort.Slice(objects, func(i, j int) bool { return objects[i].ID < objects[j].ID })
+
+		for _, object := range objects {
+			if err := g.exportObject(ctx, exp.ResourceType, object); err != nil {
+				return fmt.Errorf("exporting %s %q: %w", exp.ResourceType, object.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// exportObject reads one object through the provider and renders its import
+// and resource blocks.
+func (g *generator) exportObject(ctx context.Context, resourceType string, object listedObject) error {
+	res := g.provider.ResourcesMap[resourceType]
+	d := res.Data(nil)
+	d.SetId(object.ID)
+
+	if res.ReadContext != nil {
+		if diags := res.ReadContext(ctx, d, g.client); diags.HasError() {
+			return fmt.Errorf("%s", diags[0].Summary)
+		}
+	} else if err := res.Read(d, g.client); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		// Deleted between listing and reading
+		return nil
+	}
+
+	name := g.resourceName(resourceType, object)
+	body := g.file(resourceType).Body()
+
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBlock.SetAttributeValue("id", cty.StringVal(object.ID))
+	body.AppendNewline()
+
+	resourceBlock := body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+	values := make(map[string]interface{}, len(res.Schema))
+	for k := range res.Schema {
+		values[k] = d.Get(k)
+	}
+	g.writeAttributes(resourceBlock, res.Schema, values, name)
+	body.AppendNewline()
+
+	return nil
+}
+
+// writeAttributes renders the configurable attributes of a schema. Zero and
+// default values are omitted, sensitive values become variable references and
+// write-only values are skipped since the proxy never returns them.
+func (g *generator) writeAttributes(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}, prefix string) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		sch := s[k]
+		if !sch.Required && !sch.Optional {
+			continue
+		}
+		if sch.WriteOnly || sch.Deprecated != "" {
+			continue
+		}
+
+		value := values[k]
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+
+		if elem, ok := sch.Elem.(*schema.Resource); ok {
+			items, _ := value.([]interface{})
+			for i, item := range items {
+				itemValues, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				block := body.AppendNewBlock(k, nil).Body()
+				g.writeAttributes(block, elem.Schema, itemValues, fmt.Sprintf("%s_%s_%d", prefix, k, i))
+			}
+			continue
+		}
+
+		// The first attribute of an ExactlyOneOf or AtLeastOneOf group, such
+		// as credential_values, stands in for the group and is always written.
+		group := sch.ExactlyOneOf
+		if len(group) == 0 {
+			group = sch.AtLeastOneOf
+		}
+		required := sch.Required || (len(group) > 0 && group[0] == k)
+
+		if sch.Sensitive {
+			if isZero(value) && !required {
+				continue
+			}
+			varName := prefix + "_" + k
+			for i := 2; g.names["var."+varName]; i++ {
+				varName = fmt.Sprintf("%s_%s_%d", prefix, k, i)
+			}
+			g.names["var."+varName] = true
+			g.variables = append(g.variables, variable{Name: varName, Type: variableType(sch)})
+			body.SetAttributeTraversal(k, hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: varName},
+			})
+			continue
+		}
+
+		if isZero(value) && !required {
+			continue
+		}
+		if sch.Default != nil && fmt.Sprint(sch.Default) == fmt.Sprint(value) {
+			continue
+		}
+		body.SetAttributeValue(k, toCty(value))
+	}
+}
+
+// file returns the file holding resources of the given type.
+func (g *generator) file(resourceType string) *hclwrite.File {
+	f, ok := g.files[resourceType]
+	if !ok {
+		f = hclwrite.NewEmptyFile()
+		g.files[resourceType] = f
+	}
+	return f
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName derives a unique Terraform resource name from an object's name,
+// falling back to its ID.
+func (g *generator) resourceName(resourceType string, object listedObject) string {
+	base := invalidNameChars.ReplaceAllString(strings.ToLower(object.Name), "_")
+	base = strings.Trim(base, "_")
+	if base == "" {
+		base = invalidNameChars.ReplaceAllString(strings.ToLower(object.ID), "_")
+		base = strings.Trim(base, "_")
+		if len(base) > 12 {
+			base = base[:12]
+		}
+	}
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = strings.TrimPrefix(resourceType, "litellm_") + "_" + base
+	}
+
+	name := base
+	for i := 2; g.names[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.names[resourceType+"."+name] = true
+	return name
+}
+
+// write writes the generated files to dir.
+func (g *generator) write(dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for resourceType, f := range g.files {
+		path := filepath.Join(dir, strings.TrimPrefix(resourceType, "litellm_")+".tf")
+		if err := os.WriteFile(path, hclwrite.Format(f.Bytes()), 0o644); err != nil {
+			return nil, err
+		}
+		written = append(written, path)
+	}
+
+	if len(g.variables) > 0 {
+		f := hclwrite.NewEmptyFile()
+		for _, v := range g.variables {
+			block := f.Body().AppendNewBlock("variable", []string{v.Name}).Body()
+			if v.Type == "map" {
+				block.SetAttributeRaw("type", hclwrite.TokensForFunctionCall("map", hclwrite.TokensForIdentifier("string")))
+			} else {
+				block.SetAttributeRaw("type", hclwrite.TokensForIdentifier(v.Type))
+			}
+			block.SetAttributeValue("sensitive", cty.True)
+			f.Body().AppendNewline()
+		}
+		path := filepath.Join(dir, "variables.tf")
+		if err := os.WriteFile(path, hclwrite.Format(f.Bytes()), 0o644); err != nil {
+			return nil, err
+		}
+		written = append(written, path)
+	}
+
+	sort.Strings(written)
+	return written, nil
+}
+
+func variableType(sch *schema.Schema) string {
+	switch sch.Type {
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeInt, schema.TypeFloat:
+		return "number"
+	case schema.TypeBool:
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func toCty(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.ListValEmpty(cty.String)
+		}
+		elems := make([]cty.Value, 0, len(v))
+		for _, item := range v {
+			elems = append(elems, toCty(item))
+		}
+		return cty.TupleVal(elems)
+	case map[string]interface{}:
+		attrs := make(map[string]cty.Value, len(v))
+		for key, item := range v {
+			attrs[key] = toCty(item)
+		}
+		return cty.ObjectVal(attrs)
+	}
+	return cty.StringVal(fmt.Sprint(value))
+}
+
+func main() {
+	apiBase := flag.String("api-base", os.Getenv("LITELLM_API_BASE"), "base URL of the LiteLLM proxy (defaults to $LITELLM_API_BASE)")
+	apiKey := flag.String("api-key", os.Getenv("LITELLM_API_KEY"), "admin API key for the proxy (defaults to $LITELLM_API_KEY)")
+	insecure := flag.Bool("insecure-skip-verify", false, "skip TLS certificate verification")
+	outDir := flag.String("out", "litellm-export", "directory to write the generated .tf files to")
+	verbose := flag.Bool("v", false, "log the requests made to the proxy")
+	flag.Parse()
+
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	if *apiBase == "" || *apiKey == "" {
+		fmt.Fprintln(os.Stderr, "error: -api-base and -api-key (or LITELLM_API_BASE and LITELLM_API_KEY) are required")
+		os.Exit(2)
+	}
+
+	g := newGenerator(litellm.NewClient(*apiBase, *apiKey, *insecure))
+	if err := g.run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	written, err := g.write(*outDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	for _, path := range written {
+		fmt.Println(path)
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BerriAI/terraform-provider-litellm/litellm"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// fakeProxy serves the list and info endpoints the exporter reads.
+func fakeProxy(t *testing.T) *httptest.Server {
+	t.Helper()
+	responses := map[string]string{
+		"/organization/list": `[]`,
+		"/team/list":         `[{"team_id": "team-1", "team_alias": "Platform Team"}]`,
+		"/team/info": `{"team_id": "team-1", "team_info": {
+			"team_id": "team-1",
+			"team_alias": "Platform Team",
+			"models": ["gpt-4o"],
+			"max_budget": 100,
+			"metadata": {"owner": "infra"}
+		}}`,
+		"/team/permissions_list": `{"team_id": "team-1", "team_member_permissions": []}`,
+		"/credentials":           `{"credentials": [{"credential_name": "openai-prod", "credential_info": {"provider": "openai"}}]}`,
+		"/credentials/by_name/openai-prod": `{"credential_name": "openai-prod", "credential_info": {"provider": "openai"},
+			"credential_values": {"api_key": "sk-masked"}}`,
+		"/model/info": `{"data": [
+			{"model_name": "gpt-4o", "litellm_params": {"model": "openai/gpt-4o", "litellm_credential_name": "openai-prod"},
+			 "model_info": {"id": "m-1", "db_model": true}},
+			{"model_name": "from-config", "litellm_params": {"model": "openai/gpt-4o-mini"}, "model_info": {"id": "m-2", "db_model": false}}
+		]}`,
+		"/guardrails/list":   `{"guardrails": []}`,
+		"/v1/mcp/server":     `[]`,
+		"/vector_store/list": `{"data": [], "total_pages": 0}`,
+		"/key/list":          `{"keys": [], "total_pages": 0}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "not found"}`))
+			return
+		}
+		if r.URL.Path == "/model/info" && r.URL.Query().Get("litellm_model_id") == "m-1" {
+			body = `{"data": [{"model_name": "gpt-4o", "litellm_params": {"model": "openai/gpt-4o", "litellm_credential_name": "openai-prod"},
+				"model_info": {"id": "m-1", "db_model": true}}]}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+}
+
+func TestExportWritesResourcesImportsAndVariables(t *testing.T) {
+	srv := fakeProxy(t)
+	defer srv.Close()
+
+	g := newGenerator(litellm.NewClient(srv.URL, "sk-admin", false))
+	if err := g.run(context.Background()); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	dir := t.TempDir()
+	written, err := g.write(dir)
+	if err != nil {
+		t.Fatalf("writing files failed: %v", err)
+	}
+
+	files := make(map[string]string)
+	parser := hclparse.NewParser()
+	for _, path := range written {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, diags := parser.ParseHCL(content, path); diags.HasErrors() {
+			t.Fatalf("%s is not valid HCL: %s\n%s", path, diags.Error(), content)
+		}
+		files[filepath.Base(path)] = string(content)
+	}
+
+	if len(files) != 4 {
+		t.Fatalf("expected team, credential, model and variables files, got %v", written)
+	}
+
+	team := files["team.tf"]
+	for _, want := range []string{
+		`to = litellm_team.platform_team`,
+		`id = "team-1"`,
+		`resource "litellm_team" "platform_team"`,
+		`team_alias = "Platform Team"`,
+		`max_budget = 100`,
+		`owner = "infra"`,
+	} {
+		if !strings.Contains(team, want) {
+			t.Errorf("team.tf missing %q:\n%s", want, team)
+		}
+	}
+
+	model := files["model.tf"]
+	if !strings.Contains(model, `custom_llm_provider     = "openai"`) || !strings.Contains(model, `litellm_credential_name = "openai-prod"`) {
+		t.Errorf("model.tf missing provider or credential reference:\n%s", model)
+	}
+	if strings.Contains(model, "from-config") {
+		t.Errorf("model defined in config.yaml was exported:\n%s", model)
+	}
+
+	credential := files["credential.tf"]
+	if !strings.Contains(credential, `credential_values = var.openai_prod_credential_values`) {
+		t.Errorf("credential values not replaced by a variable:\n%s", credential)
+	}
+	if strings.Contains(credential, "sk-masked") {
+		t.Errorf("secret from the proxy written to configuration:\n%s", credential)
+	}
+
+	variables := files["variables.tf"]
+	if !strings.Contains(variables, `variable "openai_prod_credential_values"`) || !strings.Contains(variables, "sensitive = true") {
+		t.Errorf("variables.tf missing sensitive credential variable:\n%s", variables)
+	}
+}
+
+func TestResourceNameIsUniqueAndValid(t *testing.T) {
+	g := newGenerator(nil)
+
+	cases := []struct {
+		object listedObject
+		want   string
+	}{
+		{listedObject{ID: "a", Name: "Prod Key"}, "prod_key"},
+		{listedObject{ID: "b", Name: "prod-key"}, "prod_key_2"},
+		{listedObject{ID: "c", Name: "4o mini"}, "key_4o_mini"},
+		{listedObject{ID: "0123456789abcdef0123", Name: ""}, "key_0123456789ab"},
+	}
+	for _, tc := range cases {
+		if got := g.resourceName("litellm_key", tc.object); got != tc.want {
+			t.Errorf("resourceName(%+v) = %q, want %q", tc.object, got, tc.want)
+		}
+	}
+}
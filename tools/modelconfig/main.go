@@ -0,0 +1,81 @@
+// Command modelconfig converts between a LiteLLM proxy config.yaml model_list
+// and litellm_model resources.
+//
+//	modelconfig to-hcl  -in config.yaml -out models.tf
+//	modelconfig to-yaml -in state.json  -out model_list.yaml
+//
+// to-hcl maps each model_list entry onto typed litellm_model attributes,
+// passes unknown litellm_params through additional_litellm_params and turns
+// os.environ/ references into Terraform variables.
+//
+// to-yaml reads the litellm_model resources of a state file (`terraform show
+// -json` output or a raw terraform.tfstate) and renders them as model_list
+// entries using the provider's own request builder, so the YAML matches what
+// the provider sends to /model/new. Sensitive values are written as
+// os.environ/ references instead of plaintext.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	in := flags.String("in", "", "input file (defaults to stdin)")
+	out := flags.String("out", "", "output file (defaults to stdout)")
+	flags.Parse(os.Args[2:])
+
+	input, err := readInput(*in)
+	if err != nil {
+		fail(err)
+	}
+
+	var output []byte
+	var warnings []string
+	switch os.Args[1] {
+	case "to-hcl":
+		output, warnings, err = configToHCL(input)
+	case "to-yaml":
+		output, warnings, err = stateToYAML(input)
+	default:
+		usage()
+	}
+	if err != nil {
+		fail(err)
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(output)
+		return
+	}
+	if err := os.WriteFile(*out, output, 0o644); err != nil {
+		fail(err)
+	}
+}
+
+func readInput(path string) ([]byte, error) {
+	if path == "" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: modelconfig to-hcl|to-yaml [-in file] [-out file]")
+	os.Exit(2)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	os.Exit(1)
+}
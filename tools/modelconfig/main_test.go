@@ -0,0 +1,285 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/BerriAI/terraform-provider-litellm/litellm"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"gopkg.in/yaml.v3"
+)
+
+const testConfig = `
+model_list:
+  - model_name: gpt-4o
+    litellm_params:
+      model: azure/gpt-4o-prod
+      api_base: https://example.openai.azure.com
+      api_key: os.environ/AZURE_API_KEY
+      api_version: "2024-08-01-preview"
+      rpm: 600
+      input_cost_per_token: 0.0000025
+      output_cost_per_token: 0.00001
+      timeout: 30
+      drop_params: true
+      organization: os.environ/AZURE_ORG
+    model_info:
+      id: from-config
+      base_model: azure/gpt-4o
+      mode: chat
+      max_input_tokens: 128000
+      supports_vision: true
+      access_groups: ["beta"]
+      supports_audio_input: false
+  - model_name: gpt-4o
+    litellm_params:
+      model: openai/gpt-4o
+      api_key: os.environ/OPENAI_API_KEY
+      tpm: 0
+  - model_name: claude
+    litellm_params:
+      model: claude-sonnet-4
+      custom_llm_provider: anthropic
+      api_key: os.environ/ANTHROPIC_API_KEY
+      reasoning_effort: minimal
+      thinking:
+        type: enabled
+        budget_tokens: 2048
+  - model_name: claude-bedrock
+    litellm_params:
+      model: bedrock/anthropic.claude-3-5-sonnet
+      aws_region_name: us-east-1
+      aws_secret_access_key: os.environ/AWS_SECRET_ACCESS_KEY
+`
+
+func TestConfigToHCL(t *testing.T) {
+	output, warnings, err := configToHCL([]byte(testConfig))
+	if err != nil {
+		t.Fatalf("conversion failed: %v", err)
+	}
+	if _, diags := hclparse.NewParser().ParseHCL(output, "models.tf"); diags.HasErrors() {
+		t.Fatalf("output is not valid HCL: %s\n%s", diags.Error(), output)
+	}
+
+	hcl := strings.Join(strings.Fields(string(output)), " ")
+	for _, want := range []string{
+		`resource "litellm_model" "gpt_4o"`,
+		`resource "litellm_model" "gpt_4o_2"`,
+		`resource "litellm_model" "claude"`,
+		`custom_llm_provider = "azure"`,
+		`base_model          = "gpt-4o-prod"`,
+		`pricing_base_model             = "azure/gpt-4o"`,
+		`input_cost_per_million_tokens  = 2.5`,
+		`output_cost_per_million_tokens = 10`,
+		`model_api_key                  = var.azure_api_key`,
+		`organization = var.azure_org`,
+		`timeout      = "30"`,
+		`drop_params  = "true"`,
+		`tpm = "0"`,
+		`reasoning_effort = "minimal"`,
+		`thinking_enabled       = true`,
+		`thinking_budget_tokens = 2048`,
+		`variable "anthropic_api_key"`,
+		`variable "azure_org"`,
+		`model_info { access_groups = ["beta"] max_input_tokens = 128000 supports_vision = true }`,
+		`aws { region_name = "us-east-1" secret_access_key = var.aws_secret_access_key }`,
+	} {
+		if !strings.Contains(hcl, strings.Join(strings.Fields(want), " ")) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(hcl, "aws_region_name") {
+		t.Errorf("deprecated aws_region_name written instead of the aws block:\n%s", output)
+	}
+	if strings.Contains(hcl, "from-config") {
+		t.Errorf("proxy-assigned model_info.id written to configuration:\n%s", output)
+	}
+
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "model_info.supports_audio_input") {
+		t.Errorf("expected a warning for the dropped model_info key, got %v", warnings)
+	}
+}
+
+func TestConfigToHCLRequiresProvider(t *testing.T) {
+	_, _, err := configToHCL([]byte("model_list:\n  - model_name: m\n    litellm_params:\n      model: gpt-4o\n"))
+	if err == nil || !strings.Contains(err.Error(), "custom_llm_provider") {
+		t.Fatalf("expected an error asking for custom_llm_provider, got %v", err)
+	}
+}
+
+// TestConvertedEntriesRoundTrip checks that every converted entry makes the
+// provider send the litellm_params the config.yaml entry started with.
+func TestConvertedEntriesRoundTrip(t *testing.T) {
+	var config proxyConfig
+	if err := yaml.Unmarshal([]byte(testConfig), &config); err != nil {
+		t.Fatal(err)
+	}
+	res := litellm.Provider().ResourcesMap["litellm_model"]
+
+	for _, entry := range config.ModelList {
+		attrs, _, err := convertEntry(res.Schema, entry)
+		if err != nil {
+			t.Fatalf("converting %q: %v", entry.ModelName, err)
+		}
+
+		d := res.Data(nil)
+		for k, v := range attrs {
+			switch value := v.(type) {
+			case envVar:
+				v = envPrefix + string(value)
+			case nestedBlock:
+				fields := make(map[string]interface{}, len(value))
+				for fk, fv := range value {
+					if env, ok := fv.(envVar); ok {
+						fv = envPrefix + string(env)
+					}
+					fields[fk] = fv
+				}
+				v = []interface{}{fields}
+			case map[string]interface{}:
+				params := make(map[string]interface{}, len(value))
+				for pk, pv := range value {
+					if env, ok := pv.(envVar); ok {
+						pv = envPrefix + string(env)
+					}
+					params[pk] = pv
+				}
+				v = params
+			}
+			if err := d.Set(k, v); err != nil {
+				t.Fatalf("setting %s: %v", k, err)
+			}
+		}
+
+		want := make(map[string]interface{})
+		for k, v := range entry.LiteLLMParams {
+			want[k] = v
+		}
+		want["custom_llm_provider"] = attrs["custom_llm_provider"]
+		want["model"] = attrs["custom_llm_provider"].(string) + "/" + attrs["base_model"].(string)
+		if _, ok := want["merge_reasoning_content_in_choices"]; !ok {
+			want["merge_reasoning_content_in_choices"] = false
+		}
+
+		req := litellm.BuildModelRequest(d, "")
+		if !reflect.DeepEqual(normalize(t, req.LiteLLMParams), normalize(t, want)) {
+			t.Errorf("%s: litellm_params did not round-trip\n got: %v\nwant: %v", entry.ModelName, req.LiteLLMParams, want)
+		}
+		if info := req.ModelInfo; entry.ModelInfo["max_input_tokens"] != nil &&
+			(info.MaxInputTokens == nil || *info.MaxInputTokens != 128000 || info.SupportsVision == nil || !*info.SupportsVision) {
+			t.Errorf("%s: model_info did not round-trip: %+v", entry.ModelName, info)
+		}
+	}
+}
+
+func TestStateToYAML(t *testing.T) {
+	state := `{
+		"format_version": "1.0",
+		"values": {"root_module": {
+			"resources": [{
+				"address": "litellm_model.gpt4o",
+				"mode": "managed",
+				"type": "litellm_model",
+				"values": {
+					"id": "m-1",
+					"model_name": "gpt-4o",
+					"custom_llm_provider": "openai",
+					"base_model": "gpt-4o",
+					"model_api_key": "sk-plaintext",
+					"tier": "paid",
+					"mode": "chat",
+					"rpm": 600,
+					"thinking_enabled": false,
+					"thinking_budget_tokens": 1024,
+					"input_cost_per_million_tokens": 2.5,
+					"additional_litellm_params": {"timeout": "30"},
+					"model_info": [{"max_output_tokens": 16384, "supports_function_calling": true, "cache_read_input_token_cost": 1.25e-06, "access_groups": []}]
+				}
+			}, {
+				"address": "litellm_model.bedrock",
+				"mode": "managed",
+				"type": "litellm_model",
+				"values": {
+					"model_name": "claude",
+					"custom_llm_provider": "bedrock",
+					"base_model": "anthropic.claude-3-5-sonnet",
+					"aws": [{"region_name": "us-east-1", "secret_access_key": "aws-plaintext"}]
+				}
+			}],
+			"child_modules": [{"resources": [{
+				"address": "module.extra.litellm_model.embed[0]",
+				"mode": "managed",
+				"type": "litellm_model",
+				"values": {"model_name": "embed", "custom_llm_provider": "openai", "base_model": "text-embedding-3-small", "model_api_key": null, "model_api_key_wo": null, "model_api_key_wo_version": 1}
+			}]}]
+		}}
+	}`
+
+	output, warnings, err := stateToYAML([]byte(state))
+	if err != nil {
+		t.Fatalf("conversion failed: %v", err)
+	}
+	if strings.Contains(string(output), "sk-plaintext") || strings.Contains(string(output), "aws-plaintext") {
+		t.Fatalf("secret from state written to YAML:\n%s", output)
+	}
+	if len(warnings) != 3 || !strings.Contains(warnings[0], "os.environ/GPT4O_MODEL_API_KEY") || !strings.Contains(warnings[1], "os.environ/BEDROCK_AWS_SECRET_ACCESS_KEY") ||
+		!strings.Contains(warnings[2], "os.environ/MODULE_EXTRA_EMBED_0_MODEL_API_KEY") {
+		t.Errorf("expected warnings naming the environment variables, got %v", warnings)
+	}
+
+	var config proxyConfig
+	if err := yaml.Unmarshal(output, &config); err != nil {
+		t.Fatalf("output is not valid YAML: %v\n%s", err, output)
+	}
+	if len(config.ModelList) != 3 {
+		t.Fatalf("expected 3 models, got %d:\n%s", len(config.ModelList), output)
+	}
+
+	params := config.ModelList[0].LiteLLMParams
+	if params["model"] != "openai/gpt-4o" || params["api_key"] != "os.environ/GPT4O_MODEL_API_KEY" {
+		t.Errorf("unexpected litellm_params: %v", params)
+	}
+	if params["input_cost_per_token"] != 2.5e-06 || params["rpm"] != 600 || params["timeout"] != 30 {
+		t.Errorf("costs, limits or additional params not rendered: %v", params)
+	}
+	if _, ok := params["thinking"]; ok {
+		t.Errorf("thinking rendered while disabled: %v", params)
+	}
+	if info := config.ModelList[0].ModelInfo; info["tier"] != "paid" || info["base_model"] != "gpt-4o" {
+		t.Errorf("unexpected model_info: %v", info)
+	}
+	if info := config.ModelList[0].ModelInfo; info["max_output_tokens"] != 16384 || info["supports_function_calling"] != true || info["cache_read_input_token_cost"] != 1.25e-06 {
+		t.Errorf("model_info block not rendered: %v", info)
+	}
+	if params := config.ModelList[1].LiteLLMParams; params["aws_region_name"] != "us-east-1" || params["aws_secret_access_key"] != "os.environ/BEDROCK_AWS_SECRET_ACCESS_KEY" {
+		t.Errorf("aws block not rendered: %v", params)
+	}
+	if params := config.ModelList[2].LiteLLMParams; params["api_key"] != "os.environ/MODULE_EXTRA_EMBED_0_MODEL_API_KEY" {
+		t.Errorf("write-only model_api_key_wo not rendered as an environment reference: %v", params)
+	}
+	if _, ok := config.ModelList[0].ModelInfo["id"]; ok {
+		t.Errorf("model ID rendered into config.yaml: %v", config.ModelList[0].ModelInfo)
+	}
+
+	// The rendered model_list converts back to the same resource.
+	hcl, _, err := configToHCL(output)
+	if err != nil {
+		t.Fatalf("converting the rendered YAML back failed: %v", err)
+	}
+	if !strings.Contains(string(hcl), "var.gpt4o_model_api_key") || !strings.Contains(string(hcl), "var.bedrock_aws_secret_access_key") {
+		t.Errorf("round trip lost the API key reference:\n%s", hcl)
+	}
+}
+
+func normalize(t *testing.T, v interface{}) interface{} {
+	t.Helper()
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded interface{}
+	json.Unmarshal(encoded, &decoded)
+	return decoded
+}
@@ -0,0 +1,496 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BerriAI/terraform-provider-litellm/litellm"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix marks a config.yaml value read from the proxy's environment.
+const envPrefix = "os.environ/"
+
+// envVar is a value taken from an environment variable. It is rendered as a
+// reference to a Terraform variable of the same (lower-cased) name.
+type envVar string
+
+// paramAttributes maps litellm_params keys onto the typed litellm_model
+// attributes BuildModelRequest reads them from. "model", "custom_llm_provider",
+// "thinking" and the per-token costs are converted separately.
+var paramAttributes = map[string]string{
+	"api_key":                            "model_api_key",
+	"api_base":                           "model_api_base",
+	"api_version":                        "api_version",
+	"tpm":                                "tpm",
+	"rpm":                                "rpm",
+	"reasoning_effort":                   "reasoning_effort",
+	"merge_reasoning_content_in_choices": "merge_reasoning_content_in_choices",
+	"input_cost_per_pixel":               "input_cost_per_pixel",
+	"output_cost_per_pixel":              "output_cost_per_pixel",
+	"input_cost_per_second":              "input_cost_per_second",
+	"output_cost_per_second":             "output_cost_per_second",
+	"litellm_credential_name":            "litellm_credential_name",
+}
+
+// paramBlockFields maps litellm_params keys onto fields of the aws and vertex
+// blocks, which replace the deprecated aws_* and vertex_* attributes. They are
+// only used when the block applies to the model's provider.
+var paramBlockFields = map[string][2]string{
+	"aws_access_key_id":     {"aws", "access_key_id"},
+	"aws_secret_access_key": {"aws", "secret_access_key"},
+	"aws_region_name":       {"aws", "region_name"},
+	"aws_session_name":      {"aws", "session_name"},
+	"aws_role_name":         {"aws", "role_name"},
+	"vertex_project":        {"vertex", "project"},
+	"vertex_location":       {"vertex", "location"},
+	"vertex_credentials":    {"vertex", "credentials"},
+}
+
+// perTokenCosts maps per-token cost params onto the per-million attributes.
+var perTokenCosts = map[string]string{
+	"input_cost_per_token":  "input_cost_per_million_tokens",
+	"output_cost_per_token": "output_cost_per_million_tokens",
+}
+
+// modelInfoAttributes maps model_info keys onto litellm_model attributes.
+// base_model is converted to pricing_base_model separately; id and db_model
+// are assigned by the proxy.
+var modelInfoAttributes = map[string]string{
+	"tier":    "tier",
+	"mode":    "mode",
+	"team_id": "team_id",
+}
+
+// nestedBlock holds the attributes of a litellm_model block such as
+// model_info or aws.
+type nestedBlock map[string]interface{}
+
+type modelEntry struct {
+	ModelName     string                 `yaml:"model_name"`
+	LiteLLMParams map[string]interface{} `yaml:"litellm_params"`
+	ModelInfo     map[string]interface{} `yaml:"model_info,omitempty"`
+}
+
+type proxyConfig struct {
+	ModelList []modelEntry `yaml:"model_list"`
+}
+
+// convertEntry maps one model_list entry onto litellm_model attributes. Values
+// that do not fit a typed attribute, including zero values BuildModelRequest
+// would omit, go to additional_litellm_params so they are still sent.
+func convertEntry(s map[string]*schema.Schema, entry modelEntry) (map[string]interface{}, []string, error) {
+	if entry.ModelName == "" {
+		return nil, nil, fmt.Errorf("model_list entry without model_name")
+	}
+
+	attrs := map[string]interface{}{"model_name": entry.ModelName}
+	additional := make(map[string]interface{})
+	blocks := make(map[string]nestedBlock)
+	var warnings []string
+
+	setTyped := func(attr string, value interface{}) bool {
+		v, ok := coerce(s[attr], value)
+		if !ok || isZero(v) {
+			return false
+		}
+		if _, isEnv := v.(envVar); !isEnv && s[attr].ValidateFunc != nil {
+			if _, errs := s[attr].ValidateFunc(v, attr); len(errs) > 0 {
+				return false
+			}
+		}
+		attrs[attr] = v
+		return true
+	}
+
+	model, _ := entry.LiteLLMParams["model"].(string)
+	if model == "" {
+		return nil, nil, fmt.Errorf("model %q: litellm_params.model must be a string", entry.ModelName)
+	}
+	provider, _ := entry.LiteLLMParams["custom_llm_provider"].(string)
+	baseModel := model
+	if provider != "" && !strings.HasPrefix(provider, envPrefix) {
+		baseModel = strings.TrimPrefix(model, provider+"/")
+	} else if i := strings.Index(model, "/"); i > 0 {
+		provider, baseModel = model[:i], model[i+1:]
+	} else {
+		return nil, nil, fmt.Errorf("model %q: cannot determine the provider of %q, set litellm_params.custom_llm_provider", entry.ModelName, model)
+	}
+	attrs["custom_llm_provider"] = provider
+	attrs["base_model"] = baseModel
+
+	for key, value := range entry.LiteLLMParams {
+		switch key {
+		case "model", "custom_llm_provider":
+			continue
+		case "thinking":
+			if enabled, budget, ok := thinkingConfig(value); ok {
+				attrs["thinking_enabled"] = enabled
+				attrs["thinking_budget_tokens"] = budget
+				continue
+			}
+		}
+
+		if attr, ok := perTokenCosts[key]; ok {
+			if cost, ok := coerce(s[attr], value); ok {
+				if perToken, ok := cost.(float64); ok && perToken > 0 {
+					attrs[attr] = math.Round(perToken*1e6*1e6) / 1e6
+					continue
+				}
+			}
+		}
+		if attr, ok := paramAttributes[key]; ok && setTyped(attr, value) {
+			continue
+		}
+		if field, ok := paramBlockFields[key]; ok && litellm.ModelProviderFamily(provider) == field[0] {
+			if v, ok := coerce(s[field[0]].Elem.(*schema.Resource).Schema[field[1]], value); ok && !isZero(v) {
+				if blocks[field[0]] == nil {
+					blocks[field[0]] = make(nestedBlock)
+				}
+				blocks[field[0]][field[1]] = v
+				continue
+			}
+		}
+
+		encoded, err := additionalValue(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("model %q: litellm_params.%s: %w", entry.ModelName, key, err)
+		}
+		additional[key] = encoded
+	}
+	if len(additional) > 0 {
+		attrs["additional_litellm_params"] = additional
+	}
+	for name, block := range blocks {
+		attrs[name] = block
+	}
+
+	infoSchema := s["model_info"].Elem.(*schema.Resource).Schema
+	infoBlock := make(nestedBlock)
+	for key, value := range entry.ModelInfo {
+		switch key {
+		case "id", "db_model":
+			continue
+		case "base_model":
+			if pricing, ok := value.(string); ok && pricing != baseModel {
+				attrs["pricing_base_model"] = pricing
+			}
+			continue
+		}
+		if attr, ok := modelInfoAttributes[key]; ok && setTyped(attr, value) {
+			continue
+		}
+		if sch, ok := infoSchema[key]; ok {
+			if v, ok := coerce(sch, value); ok {
+				infoBlock[key] = v
+				continue
+			}
+		}
+		warnings = append(warnings, fmt.Sprintf("model %q: model_info.%s has no litellm_model attribute and was dropped", entry.ModelName, key))
+	}
+	if len(infoBlock) > 0 {
+		attrs["model_info"] = infoBlock
+	}
+	sort.Strings(warnings)
+
+	return attrs, warnings, nil
+}
+
+// thinkingConfig recognises the {type: enabled, budget_tokens: N} shape
+// BuildModelRequest produces from thinking_enabled and thinking_budget_tokens.
+func thinkingConfig(value interface{}) (bool, int, bool) {
+	thinking, ok := value.(map[string]interface{})
+	if !ok || len(thinking) != 2 || thinking["type"] != "enabled" {
+		return false, 0, false
+	}
+	budget, ok := thinking["budget_tokens"].(int)
+	return true, budget, ok
+}
+
+// coerce converts a YAML value to the Go type of a schema attribute.
+// os.environ/ references become envVar values.
+func coerce(sch *schema.Schema, value interface{}) (interface{}, bool) {
+	if str, ok := value.(string); ok && strings.HasPrefix(str, envPrefix) {
+		return envVar(strings.TrimPrefix(str, envPrefix)), true
+	}
+
+	switch sch.Type {
+	case schema.TypeString:
+		v, ok := value.(string)
+		return v, ok
+	case schema.TypeBool:
+		v, ok := value.(bool)
+		return v, ok
+	case schema.TypeInt:
+		switch v := value.(type) {
+		case int:
+			return v, true
+		case float64:
+			return int(v), v == math.Trunc(v)
+		}
+	case schema.TypeFloat:
+		switch v := value.(type) {
+		case int:
+			return float64(v), true
+		case float64:
+			return v, true
+		}
+	case schema.TypeList:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		list := make([]string, 0, len(items))
+		for _, item := range items {
+			str, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			list = append(list, str)
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+// additionalValue encodes a litellm_params value as an
+// additional_litellm_params string. Non-string values are JSON encoded, which
+// the provider decodes back before sending them.
+func additionalValue(value interface{}) (interface{}, error) {
+	if str, ok := value.(string); ok {
+		if strings.HasPrefix(str, envPrefix) {
+			return envVar(strings.TrimPrefix(str, envPrefix)), nil
+		}
+		return str, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	}
+	return false
+}
+
+// variable is an input variable declared for an os.environ/ reference.
+type variable struct {
+	Type      string
+	Sensitive bool
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func sanitizeName(name string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+// configToHCL converts the model_list of a proxy config.yaml into
+// litellm_model resources and the variables they reference.
+func configToHCL(input []byte) ([]byte, []string, error) {
+	var config proxyConfig
+	if err := yaml.Unmarshal(input, &config); err != nil {
+		return nil, nil, fmt.Errorf("parsing config: %w", err)
+	}
+	if len(config.ModelList) == 0 {
+		return nil, nil, fmt.Errorf("config has no model_list entries")
+	}
+
+	s := litellm.Provider().ResourcesMap["litellm_model"].Schema
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	variables := make(map[string]variable)
+	names := make(map[string]bool)
+	var warnings []string
+
+	for _, entry := range config.ModelList {
+		attrs, entryWarnings, err := convertEntry(s, entry)
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, entryWarnings...)
+
+		// Deployments sharing a model_name are load-balanced, so names repeat.
+		base := sanitizeName(entry.ModelName)
+		if base == "" || (base[0] >= '0' && base[0] <= '9') {
+			base = "model_" + base
+		}
+		name := base
+		for i := 2; names[name]; i++ {
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+		names[name] = true
+
+		block := body.AppendNewBlock("resource", []string{"litellm_model", name}).Body()
+		for _, k := range attributeOrder(attrs) {
+			switch v := attrs[k].(type) {
+			case map[string]interface{}:
+				block.SetAttributeRaw(k, additionalTokens(variables, v))
+			case nestedBlock:
+				block.AppendNewline()
+				nestedBody := block.AppendNewBlock(k, nil).Body()
+				for _, nk := range sortedKeys(v) {
+					setAttribute(nestedBody, variables, s[k].Elem.(*schema.Resource).Schema[nk], nk, v[nk])
+				}
+			default:
+				setAttribute(block, variables, s[k], k, v)
+			}
+		}
+		body.AppendNewline()
+	}
+
+	varNames := make([]string, 0, len(variables))
+	for name := range variables {
+		varNames = append(varNames, name)
+	}
+	sort.Strings(varNames)
+	for _, name := range varNames {
+		v := variables[name]
+		block := body.AppendNewBlock("variable", []string{name}).Body()
+		block.SetAttributeRaw("type", hclwrite.TokensForIdentifier(v.Type))
+		if v.Sensitive {
+			block.SetAttributeValue("sensitive", cty.True)
+		}
+		body.AppendNewline()
+	}
+
+	return hclwrite.Format(f.Bytes()), warnings, nil
+}
+
+// attributeOrder lists model_name, custom_llm_provider and base_model first,
+// then the other attributes alphabetically, additional_litellm_params and
+// finally the nested blocks alphabetically.
+func attributeOrder(attrs map[string]interface{}) []string {
+	order := []string{"model_name", "custom_llm_provider", "base_model"}
+	var rest, blocks []string
+	for k, v := range attrs {
+		switch k {
+		case "model_name", "custom_llm_provider", "base_model", "additional_litellm_params":
+			continue
+		}
+		if _, ok := v.(nestedBlock); ok {
+			blocks = append(blocks, k)
+			continue
+		}
+		rest = append(rest, k)
+	}
+	sort.Strings(rest)
+	sort.Strings(blocks)
+	order = append(order, rest...)
+	if _, ok := attrs["additional_litellm_params"]; ok {
+		order = append(order, "additional_litellm_params")
+	}
+	return append(order, blocks...)
+}
+
+// setAttribute writes a typed attribute, rendering environment references as
+// variables.
+func setAttribute(body *hclwrite.Body, variables map[string]variable, sch *schema.Schema, name string, value interface{}) {
+	if env, ok := value.(envVar); ok {
+		body.SetAttributeTraversal(name, variableRef(variables, env, schemaVariableType(sch), sch.Sensitive))
+		return
+	}
+	body.SetAttributeValue(name, toCty(value))
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// variableRef declares the variable for an environment reference, reusing it
+// when several models read the same environment variable.
+func variableRef(variables map[string]variable, env envVar, varType string, sensitive bool) hcl.Traversal {
+	name := sanitizeName(string(env))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "env_" + name
+	}
+	v := variables[name]
+	v.Type = varType
+	v.Sensitive = v.Sensitive || sensitive
+	variables[name] = v
+	return hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}}
+}
+
+// additionalTokens renders additional_litellm_params. Unknown params may hold
+// secrets, so their environment references become sensitive variables.
+func additionalTokens(variables map[string]variable, params map[string]interface{}) hclwrite.Tokens {
+	keys := sortedKeys(params)
+	items := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+	for _, k := range keys {
+		name := hclwrite.TokensForValue(cty.StringVal(k))
+		if hclsyntax.ValidIdentifier(k) {
+			name = hclwrite.TokensForIdentifier(k)
+		}
+
+		var value hclwrite.Tokens
+		switch v := params[k].(type) {
+		case envVar:
+			value = hclwrite.TokensForTraversal(variableRef(variables, v, "string", true))
+		default:
+			value = hclwrite.TokensForValue(cty.StringVal(v.(string)))
+		}
+		items = append(items, hclwrite.ObjectAttrTokens{Name: name, Value: value})
+	}
+	return hclwrite.TokensForObject(items)
+}
+
+func schemaVariableType(sch *schema.Schema) string {
+	switch sch.Type {
+	case schema.TypeInt, schema.TypeFloat:
+		return "number"
+	case schema.TypeBool:
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+func toCty(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case []string:
+		if len(v) == 0 {
+			return cty.ListValEmpty(cty.String)
+		}
+		items := make([]cty.Value, len(v))
+		for i, item := range v {
+			items[i] = cty.StringVal(item)
+		}
+		return cty.ListVal(items)
+	}
+	return cty.StringVal(fmt.Sprint(value))
+}
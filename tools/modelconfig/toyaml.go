@@ -0,0 +1,241 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BerriAI/terraform-provider-litellm/litellm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// stateResource is a litellm_model instance found in a state file.
+type stateResource struct {
+	Address string
+	Values  map[string]interface{}
+}
+
+// showModule is a module in `terraform show -json` output.
+type showModule struct {
+	Resources []struct {
+		Address string                 `json:"address"`
+		Mode    string                 `json:"mode"`
+		Type    string                 `json:"type"`
+		Values  map[string]interface{} `json:"values"`
+	} `json:"resources"`
+	ChildModules []showModule `json:"child_modules"`
+}
+
+// stateFile covers both `terraform show -json` output and a raw
+// terraform.tfstate (format version 4).
+type stateFile struct {
+	Values *struct {
+		RootModule showModule `json:"root_module"`
+	} `json:"values"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func (m showModule) models() []stateResource {
+	var found []stateResource
+	for _, r := range m.Resources {
+		if r.Mode == "managed" && r.Type == "litellm_model" {
+			found = append(found, stateResource{Address: r.Address, Values: r.Values})
+		}
+	}
+	for _, child := range m.ChildModules {
+		found = append(found, child.models()...)
+	}
+	return found
+}
+
+func parseState(input []byte) ([]stateResource, error) {
+	var state stateFile
+	if err := json.Unmarshal(input, &state); err != nil {
+		return nil, fmt.Errorf("parsing state: %w", err)
+	}
+	if state.Values != nil {
+		return state.Values.RootModule.models(), nil
+	}
+
+	var found []stateResource
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || r.Type != "litellm_model" {
+			continue
+		}
+		address := r.Type + "." + r.Name
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+		for _, instance := range r.Instances {
+			instanceAddress := address
+			switch key := instance.IndexKey.(type) {
+			case string:
+				instanceAddress = fmt.Sprintf("%s[%q]", address, key)
+			case float64:
+				instanceAddress = fmt.Sprintf("%s[%d]", address, int(key))
+			}
+			found = append(found, stateResource{Address: instanceAddress, Values: instance.Attributes})
+		}
+	}
+	return found, nil
+}
+
+// stateToYAML renders the litellm_model resources of a state file as a
+// config.yaml model_list.
+func stateToYAML(input []byte) ([]byte, []string, error) {
+	resources, err := parseState(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(resources) == 0 {
+		return nil, nil, fmt.Errorf("state has no litellm_model resources")
+	}
+
+	res := litellm.Provider().ResourcesMap["litellm_model"]
+	var entries []modelEntry
+	var warnings []string
+
+	for _, r := range resources {
+		d := res.Data(nil)
+		envPrefixName := strings.ToUpper(sanitizeName(strings.Replace(r.Address, "litellm_model.", "", 1)))
+
+		keys := make([]string, 0, len(r.Values))
+		for k := range r.Values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sch, ok := res.Schema[k]
+			value := r.Values[k]
+			if !ok || value == nil || (!sch.Required && !sch.Optional) {
+				continue
+			}
+			if f, ok := value.(float64); ok && sch.Type == schema.TypeInt {
+				value = int(f)
+			}
+			if str, ok := value.(string); ok && sch.Sensitive && str != "" {
+				env := envPrefixName + "_" + strings.ToUpper(k)
+				value = envPrefix + env
+				warnings = append(warnings, fmt.Sprintf("%s: %s is written as %s%s, set it in the proxy environment", r.Address, k, envPrefix, env))
+			}
+			if elem, ok := sch.Elem.(*schema.Resource); ok {
+				value = redactBlock(elem, value, func(field string) string {
+					env := envPrefixName + "_" + strings.ToUpper(k+"_"+field)
+					warnings = append(warnings, fmt.Sprintf("%s: %s.%s is written as %s%s, set it in the proxy environment", r.Address, k, field, envPrefix, env))
+					return envPrefix + env
+				})
+			}
+			if err := d.Set(k, value); err != nil {
+				return nil, nil, fmt.Errorf("%s: setting %s: %w", r.Address, k, err)
+			}
+
+			// Write-only secrets never reach state. A set *_wo_version means
+			// one is configured, so it is written as an environment reference.
+			if base := strings.TrimSuffix(k, "_wo_version"); base != k && writeOnlyVersionSet(value) {
+				if current, _ := r.Values[base].(string); current == "" && res.Schema[base] != nil {
+					env := envPrefixName + "_" + strings.ToUpper(base)
+					warnings = append(warnings, fmt.Sprintf("%s: write-only %s_wo is written as %s%s, set it in the proxy environment", r.Address, base, envPrefix, env))
+					if err := d.Set(base, envPrefix+env); err != nil {
+						return nil, nil, fmt.Errorf("%s: setting %s: %w", r.Address, base, err)
+					}
+				}
+			}
+		}
+
+		req := litellm.BuildModelRequest(d, "")
+		modelInfo, err := renderModelInfo(req.ModelInfo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", r.Address, err)
+		}
+
+		entries = append(entries, modelEntry{
+			ModelName:     req.ModelName,
+			LiteLLMParams: req.LiteLLMParams,
+			ModelInfo:     modelInfo,
+		})
+	}
+
+	output, err := yaml.Marshal(proxyConfig{ModelList: entries})
+	if err != nil {
+		return nil, nil, err
+	}
+	return output, warnings, nil
+}
+
+// renderModelInfo converts the model_info BuildModelRequest produced into its
+// config.yaml form, leaving out the proxy-assigned id and db_model and empty
+// strings.
+func renderModelInfo(info litellm.ModelInfo) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	var modelInfo map[string]interface{}
+	if err := json.Unmarshal(encoded, &modelInfo); err != nil {
+		return nil, err
+	}
+
+	delete(modelInfo, "id")
+	delete(modelInfo, "db_model")
+	for key, value := range modelInfo {
+		if value == "" {
+			delete(modelInfo, key)
+		}
+	}
+	return modelInfo, nil
+}
+
+// redactBlock replaces the sensitive fields of a nested block, such as
+// aws.secret_access_key, with the environment references envRef returns.
+// Fields configured through their write-only <field>_wo variant get a
+// reference as well.
+func redactBlock(elem *schema.Resource, value interface{}, envRef func(field string) string) interface{} {
+	items, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+	redacted := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			redacted = append(redacted, item)
+			continue
+		}
+		copied := make(map[string]interface{}, len(fields))
+		for _, field := range sortedKeys(fields) {
+			copied[field] = fields[field]
+			sch := elem.Schema[field]
+			if sch == nil || !sch.Sensitive || sch.WriteOnly {
+				continue
+			}
+			if str, _ := fields[field].(string); str != "" || writeOnlyVersionSet(fields[field+"_wo_version"]) {
+				copied[field] = envRef(field)
+			}
+		}
+		redacted = append(redacted, copied)
+	}
+	return redacted
+}
+
+// writeOnlyVersionSet reports whether a *_wo_version value from state is set.
+func writeOnlyVersionSet(value interface{}) bool {
+	switch v := value.(type) {
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	}
+	return false
+}